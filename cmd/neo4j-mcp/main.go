@@ -3,7 +3,10 @@ package main
 import (
 	"context"
 	"log"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
 	"github.com/neo4j/mcp/internal/analytics"
 	"github.com/neo4j/mcp/internal/cli"
@@ -19,11 +22,11 @@ var MixPanelEndpoint = ""
 var MixPanelToken = ""
 
 func main() {
-	// Handle CLI arguments (version, help, etc.)
-	cli.HandleArgs(Version)
+	// Handle CLI arguments (version, help, config file, etc.)
+	args := cli.HandleArgs(Version)
 
-	// get config from environment variables
-	cfg, err := config.LoadConfig()
+	// get config from flags, environment variables and the optional config file
+	cfg, err := config.LoadConfig(args.ConfigFile, args.Flags)
 	if err != nil {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
@@ -57,10 +60,10 @@ func main() {
 	isAura := strings.Contains(cfg.URI, "database.neo4j.io")
 	anService := analytics.NewAnalytics(MixPanelToken, MixPanelEndpoint, isAura)
 
-	if cfg.Telemetry == "false" || MixPanelEndpoint == "" || MixPanelToken == "" {
+	if !cfg.Telemetry || MixPanelEndpoint == "" || MixPanelToken == "" {
 		log.Println("Telemetry disabled.")
 		anService.Disable()
-	} else if cfg.Telemetry == "true" {
+	} else {
 		anService.Enable()
 		log.Println("Telemetry is enabled to help us improve the product by collecting anonymous usage data such as: tools being used, the operating system, and CPU architecture.")
 		log.Println("To disable telemetry, set the NEO4J_TELEMETRY environment variable to \"false\".")
@@ -76,6 +79,18 @@ func main() {
 		}
 	}()
 
+	// The stdio transport handles its own signals; the HTTP transport is stopped on SIGINT/SIGTERM
+	if cfg.Transport == config.TransportHTTP {
+		sigCtx, stopSignals := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stopSignals()
+		go func() {
+			<-sigCtx.Done()
+			if err := mcpServer.Stop(); err != nil {
+				log.Printf("Error stopping server: %v", err)
+			}
+		}()
+	}
+
 	// Start the server (this blocks until the server is stopped)
 	if err := mcpServer.Start(); err != nil {
 		log.Printf("Server error: %v", err)
@@ -0,0 +1,26 @@
+package auth
+
+import "context"
+
+// Principal is the identity of an authenticated MCP client
+type Principal struct {
+	// Subject identifies the client, e.g. the API key name or the JWT "sub" claim
+	Subject string
+	// Method is the authentication method used, e.g. "api-key" or "jwt"
+	Method string
+	// Claims holds the verified JWT claims; it is nil for other authentication methods
+	Claims map[string]any
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying the authenticated principal
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated principal carried by ctx, if any
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
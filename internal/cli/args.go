@@ -3,47 +3,122 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strings"
+
+	"github.com/neo4j/mcp/internal/config"
 )
 
 // osExit is a variable that can be mocked in tests
 var osExit = os.Exit
 
-const helpText = `neo4j-mcp - Neo4j Model Context Protocol Server
+const helpHeader = `neo4j-mcp - Neo4j Model Context Protocol Server
 
 Usage:
   neo4j-mcp [OPTIONS]
 
 Options:
-  -h, --help      Show this help message
-  -v, --version   Show version information
+  -c, --config <path>  Configuration file (YAML, TOML or JSON) [$NEO4J_MCP_CONFIG]
+  -h, --help           Show this help message
+  -v, --version        Show version information
+
+Configuration:
+`
 
-Environment Variables:
-  NEO4J_URI       Neo4j database URI (default: bolt://localhost:7687)
-  NEO4J_USERNAME  Database username (default: neo4j)
-  NEO4J_PASSWORD  Database password (required)
-  NEO4J_DATABASE  Database name (default: neo4j)
+const helpFooter = `
+Options are applied in order of precedence: flags, environment variables, configuration file, defaults.
+Configuration file keys are the flag names with '-' replaced by '_' (e.g. uri, read_only, http_port).
 
 Examples:
   NEO4J_PASSWORD=mypassword neo4j-mcp
-  NEO4J_URI=bolt://db.example.com:7687 NEO4J_USERNAME=admin NEO4J_PASSWORD=secret neo4j-mcp
+  neo4j-mcp --uri bolt://db.example.com:7687 --username admin --read-only
+  neo4j-mcp --config /etc/neo4j-mcp/config.yaml
 
 For more information, visit: https://github.com/neo4j/mcp
 `
 
-func HandleArgs(version string) {
+// helpText generates the help message from the configuration options, so that it documents every option
+func helpText() string {
+	opts := config.Options()
+	usages := make([]string, len(opts))
+	width := 0
+	for i, opt := range opts {
+		usages[i] = "--" + opt.Flag()
+		if opt.Type != config.TypeBool {
+			usages[i] += " <" + opt.Type + ">"
+		}
+		width = max(width, len(usages[i]))
+	}
+
+	var b strings.Builder
+	b.WriteString(helpHeader)
+	for i, opt := range opts {
+		fmt.Fprintf(&b, "  %-*s  %s [$%s]", width, usages[i], opt.Usage, opt.Env)
+		if opt.Default != "" {
+			fmt.Fprintf(&b, " (default: %s)", opt.Default)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(helpFooter)
+	return b.String()
+}
+
+// Args holds the command-line arguments that configure the server
+type Args struct {
+	// ConfigFile is the path of the configuration file, empty when not provided
+	ConfigFile string
+	// Flags maps configuration option keys to the values given on the command line
+	Flags map[string]string
+}
+
+// HandleArgs parses the command-line arguments.
+// It exits the process after handling --help and --version, or when the arguments are invalid.
+func HandleArgs(version string) Args {
+	args := Args{Flags: make(map[string]string)}
 	if len(os.Args) <= 1 {
-		return
+		return args
+	}
+
+	optionsByFlag := make(map[string]config.Option)
+	for _, opt := range config.Options() {
+		optionsByFlag[opt.Flag()] = opt
 	}
 
 	flags := make(map[string]bool)
 	var err error
 
-	for _, arg := range os.Args[1:] {
-		switch arg {
-		case "-h", "--help":
+	for i := 1; i < len(os.Args) && err == nil; i++ {
+		arg := os.Args[i]
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		switch {
+		case arg == "-h" || arg == "--help":
 			flags["help"] = true
-		case "-v", "--version":
+		case arg == "-v" || arg == "--version":
 			flags["version"] = true
+		case arg == "-c" || (strings.HasPrefix(arg, "--") && name == "config"):
+			if !hasValue {
+				if i+1 >= len(os.Args) {
+					err = fmt.Errorf("missing value for %s", arg)
+					break
+				}
+				i++
+				value = os.Args[i]
+			}
+			args.ConfigFile = value
+		case strings.HasPrefix(arg, "--") && optionsByFlag[name].Key != "":
+			opt := optionsByFlag[name]
+			if !hasValue {
+				// Boolean flags are enabled by their presence, like --read-only
+				if opt.Type == config.TypeBool {
+					value = "true"
+				} else if i+1 >= len(os.Args) {
+					err = fmt.Errorf("missing value for %s", arg)
+					break
+				} else {
+					i++
+					value = os.Args[i]
+				}
+			}
+			args.Flags[opt.Key] = value
 		default:
 			err = fmt.Errorf("unknown flag or argument: %s", arg)
 		}
@@ -55,7 +130,7 @@ func HandleArgs(version string) {
 	}
 
 	if flags["help"] {
-		fmt.Print(helpText)
+		fmt.Print(helpText())
 		osExit(0)
 	}
 
@@ -63,4 +138,6 @@ func HandleArgs(version string) {
 		fmt.Printf("neo4j-mcp version: %s\n", version)
 		osExit(0)
 	}
+
+	return args
 }
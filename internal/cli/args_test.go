@@ -128,6 +128,41 @@ func TestHandleArgs(t *testing.T) {
 			expectedExitCode: 1,
 			expectedStderr:   "unknown flag or argument: extra",
 		},
+		{
+			name:             "config flag without value",
+			args:             []string{testProgramName, "--config"},
+			version:          testVersion,
+			expectedExitCode: 1,
+			expectedStderr:   "missing value for --config",
+		},
+		{
+			name:             "option flag without value",
+			args:             []string{testProgramName, "--uri"},
+			version:          testVersion,
+			expectedExitCode: 1,
+			expectedStderr:   "missing value for --uri",
+		},
+		{
+			name:             "unknown long flag",
+			args:             []string{testProgramName, "--not-an-option", "value"},
+			version:          testVersion,
+			expectedExitCode: 1,
+			expectedStderr:   "unknown flag or argument: --not-an-option",
+		},
+		{
+			name:             "help lists configuration options",
+			args:             []string{testProgramName, "--help"},
+			version:          testVersion,
+			expectedExitCode: 0,
+			expectedOutput:   "--read-only",
+		},
+		{
+			name:             "help lists environment variables",
+			args:             []string{testProgramName, "--help"},
+			version:          testVersion,
+			expectedExitCode: 0,
+			expectedOutput:   "[$NEO4J_READ_ONLY]",
+		},
 	}
 
 	for _, tt := range tests {
@@ -180,3 +215,86 @@ func TestHandleArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleArgsConfigFile(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "no config file", args: []string{testProgramName}, want: ""},
+		{name: "long form", args: []string{testProgramName, "--config", "/etc/neo4j-mcp.yaml"}, want: "/etc/neo4j-mcp.yaml"},
+		{name: "short form", args: []string{testProgramName, "-c", "config.json"}, want: "config.json"},
+		{name: "equals form", args: []string{testProgramName, "--config=config.toml"}, want: "config.toml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalArgs := os.Args
+			t.Cleanup(func() {
+				os.Args = originalArgs
+			})
+			os.Args = tt.args
+
+			got := HandleArgs(testVersion)
+			if got.ConfigFile != tt.want {
+				t.Errorf("ConfigFile: got %q, want %q", got.ConfigFile, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleArgsFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want map[string]string
+	}{
+		{name: "no flags", args: []string{testProgramName}, want: map[string]string{}},
+		{
+			name: "string flags",
+			args: []string{testProgramName, "--uri", "bolt://db:7687", "--database=movies"},
+			want: map[string]string{"uri": "bolt://db:7687", "database": "movies"},
+		},
+		{
+			name: "bool flag without value",
+			args: []string{testProgramName, "--read-only"},
+			want: map[string]string{"read_only": "true"},
+		},
+		{
+			name: "bool flag with value",
+			args: []string{testProgramName, "--telemetry=false"},
+			want: map[string]string{"telemetry": "false"},
+		},
+		{
+			name: "int flag and config file",
+			args: []string{testProgramName, "--http-port", "9090", "-c", "config.yaml"},
+			want: map[string]string{"http_port": "9090"},
+		},
+		{
+			name: "last occurrence wins",
+			args: []string{testProgramName, "--username", "a", "--username", "b"},
+			want: map[string]string{"username": "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalArgs := os.Args
+			t.Cleanup(func() {
+				os.Args = originalArgs
+			})
+			os.Args = tt.args
+
+			got := HandleArgs(testVersion)
+			if len(got.Flags) != len(tt.want) {
+				t.Fatalf("Flags: got %v, want %v", got.Flags, tt.want)
+			}
+			for key, want := range tt.want {
+				if got.Flags[key] != want {
+					t.Errorf("Flags[%q]: got %q, want %q", key, got.Flags[key], want)
+				}
+			}
+		})
+	}
+}
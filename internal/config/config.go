@@ -3,16 +3,112 @@ package config
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
+	"time"
 )
 
+// Supported MCP transports
+const (
+	TransportStdio = "stdio"
+	TransportHTTP  = "http"
+)
+
+// Supported authentication methods for the HTTP transport
+const (
+	HTTPAuthNone   = "none"
+	HTTPAuthAPIKey = "api-key"
+	HTTPAuthJWT    = "jwt"
+)
+
+// Supported get-schema introspection strategies
+const (
+	SchemaStrategyAuto   = "auto"   // APOC when installed, native procedures otherwise
+	SchemaStrategyAPOC   = "apoc"   // apoc.meta.schema() only
+	SchemaStrategyNative = "native" // built-in db.schema procedures only
+)
+
+// Supported formats of the records returned by read-cypher and write-cypher
+const (
+	ResultFormatJSON        = "json"         // indented array of objects keyed by column
+	ResultFormatCompactJSON = "json-compact" // columns and rows arrays, without indentation
+	ResultFormatCSV         = "csv"
+	ResultFormatMarkdown    = "markdown" // Markdown table
+)
+
+// ConfigFileEnv is the environment variable holding the path of the configuration file
+const ConfigFileEnv = "NEO4J_MCP_CONFIG"
+
 // Config holds the application configuration
 type Config struct {
 	URI       string
 	Username  string
 	Password  string
 	Database  string
-	ReadOnly  string // If true, disables write tools
-	Telemetry string // if false, disables telemetry
+	ReadOnly  bool // If true, disables write tools
+	Telemetry bool // if false, disables telemetry
+
+	AllowedDatabases []string // databases tools may target besides Database, "*" allows any
+
+	MaxRows          int // maximum number of records returned by read-cypher, 0 disables the limit
+	MaxResponseBytes int // approximate maximum size of the records returned by read-cypher, 0 disables the limit
+
+	ResultFormat string // default format of the records returned by read-cypher and write-cypher, json when empty
+	MaxTokens    int    // default approximate token budget of the records returned by read-cypher and write-cypher, 0 disables it
+
+	VectorMinDimensions int      // minimum length of the numeric lists returned as a vector placeholder, 0 disables the detection
+	VectorProperties    []string // properties, map keys and columns whose numeric lists are always returned as a vector placeholder
+
+	EmbeddingProvider string // provider computing the vectors of vector-search texts, empty disables text searches
+	EmbeddingURL      string // endpoint of the embedding provider, the provider default when empty
+	EmbeddingModel    string // embedding model, matching the one the indexed vectors were computed with
+
+	QueryTimeout    time.Duration // default timeout of the transactions run by tools, 0 uses the database setting
+	MaxQueryTimeout time.Duration // maximum timeout tools may request per call, 0 disables the limit
+
+	CursorTTL            time.Duration // time a truncated result can be paginated with fetch-more, 0 disables pagination
+	MaxCursorsPerSession int           // maximum number of paginated results per client session, 0 disables the limit
+
+	SchemaStrategy string        // auto (default), apoc or native
+	SchemaCacheTTL time.Duration // time get-schema results are cached, 0 disables the cache
+
+	Transport    string // stdio (default) or http
+	HTTPHost     string // host the HTTP transport binds to
+	HTTPPort     int    // port the HTTP transport listens on
+	HTTPBasePath string // path the MCP endpoint is served under
+
+	HTTPPerRequestAuth bool // If true, each HTTP request must carry its own Neo4j credentials
+
+	HTTPAuth        string // none (default), api-key or jwt
+	HTTPAPIKeysFile string // file holding the accepted API keys when HTTPAuth is api-key
+	HTTPJWTKeyFile  string // JWKS or PEM file holding the JWT verification keys when HTTPAuth is jwt
+	HTTPJWTIssuer   string // optional required JWT "iss" claim
+	HTTPJWTAudience string // optional required JWT "aud" claim
+
+	// unknownKeys holds the keys of the configuration file that do not match any option
+	unknownKeys []string
+}
+
+// HTTPAuthEnabled reports whether the HTTP transport authenticates its clients
+func (c *Config) HTTPAuthEnabled() bool {
+	return c.Transport == TransportHTTP && c.HTTPAuth != "" && c.HTTPAuth != HTTPAuthNone
+}
+
+// AllowAnyDatabase is the AllowedDatabases entry allowing tools to target any database
+const AllowAnyDatabase = "*"
+
+// DatabaseAllowed reports whether tools may target the named database.
+// The default database is always allowed; database names are case-insensitive.
+func (c *Config) DatabaseAllowed(name string) bool {
+	if strings.EqualFold(name, c.Database) {
+		return true
+	}
+	for _, allowed := range c.AllowedDatabases {
+		if allowed == AllowAnyDatabase || strings.EqualFold(name, allowed) {
+			return true
+		}
+	}
+	return false
 }
 
 // Validate validates the configuration and returns an error if invalid
@@ -21,8 +117,22 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("configuration is required but was nil")
 	}
 
-	if c.Telemetry != "false" && c.Telemetry != "true" {
-		return fmt.Errorf("%s cannot be converted to type %s", "NEO4J_TELEMETRY", "bool")
+	if len(c.unknownKeys) > 0 {
+		return fmt.Errorf("unknown configuration file keys: %s", strings.Join(c.unknownKeys, ", "))
+	}
+
+	switch c.HTTPAuth {
+	case "", HTTPAuthNone:
+	case HTTPAuthAPIKey:
+		if c.HTTPAPIKeysFile == "" {
+			return fmt.Errorf("%s is required when %s is %q", "NEO4J_MCP_HTTP_API_KEYS_FILE", "NEO4J_MCP_HTTP_AUTH", HTTPAuthAPIKey)
+		}
+	case HTTPAuthJWT:
+		if c.HTTPJWTKeyFile == "" {
+			return fmt.Errorf("%s is required when %s is %q", "NEO4J_MCP_HTTP_JWT_KEY_FILE", "NEO4J_MCP_HTTP_AUTH", HTTPAuthJWT)
+		}
+	default:
+		return fmt.Errorf("%s must be one of %q, %q or %q but was %q", "NEO4J_MCP_HTTP_AUTH", HTTPAuthNone, HTTPAuthAPIKey, HTTPAuthJWT, c.HTTPAuth)
 	}
 
 	validations := []struct {
@@ -40,18 +150,115 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	limits := []struct {
+		value int
+		name  string
+	}{
+		{c.MaxRows, "NEO4J_MCP_MAX_ROWS"},
+		{c.MaxResponseBytes, "NEO4J_MCP_MAX_RESPONSE_BYTES"},
+		{c.MaxTokens, "NEO4J_MCP_MAX_TOKENS"},
+		{c.VectorMinDimensions, "NEO4J_MCP_VECTOR_MIN_DIMENSIONS"},
+		{c.MaxCursorsPerSession, "NEO4J_MCP_MAX_CURSORS_PER_SESSION"},
+	}
+
+	for _, l := range limits {
+		if l.value < 0 {
+			return fmt.Errorf("%s must not be negative but was %d", l.name, l.value)
+		}
+	}
+
+	durations := []struct {
+		value time.Duration
+		name  string
+	}{
+		{c.QueryTimeout, "NEO4J_MCP_QUERY_TIMEOUT"},
+		{c.MaxQueryTimeout, "NEO4J_MCP_MAX_QUERY_TIMEOUT"},
+		{c.CursorTTL, "NEO4J_MCP_CURSOR_TTL"},
+		{c.SchemaCacheTTL, "NEO4J_MCP_SCHEMA_CACHE_TTL"},
+	}
+
+	for _, d := range durations {
+		if d.value < 0 {
+			return fmt.Errorf("%s must not be negative but was %s", d.name, d.value)
+		}
+	}
+
+	if c.MaxQueryTimeout > 0 && c.QueryTimeout > c.MaxQueryTimeout {
+		return fmt.Errorf("%s must not exceed %s (%s) but was %s", "NEO4J_MCP_QUERY_TIMEOUT", "NEO4J_MCP_MAX_QUERY_TIMEOUT", c.MaxQueryTimeout, c.QueryTimeout)
+	}
+
+	switch c.SchemaStrategy {
+	case "", SchemaStrategyAuto, SchemaStrategyAPOC, SchemaStrategyNative:
+	default:
+		return fmt.Errorf("%s must be one of %q, %q or %q but was %q", "NEO4J_MCP_SCHEMA_STRATEGY", SchemaStrategyAuto, SchemaStrategyAPOC, SchemaStrategyNative, c.SchemaStrategy)
+	}
+
+	switch c.ResultFormat {
+	case "", ResultFormatJSON, ResultFormatCompactJSON, ResultFormatCSV, ResultFormatMarkdown:
+	default:
+		return fmt.Errorf("%s must be one of %q, %q, %q or %q but was %q", "NEO4J_MCP_RESULT_FORMAT", ResultFormatJSON, ResultFormatCompactJSON, ResultFormatCSV, ResultFormatMarkdown, c.ResultFormat)
+	}
+
+	switch c.Transport {
+	case "", TransportStdio:
+	case TransportHTTP:
+		if c.HTTPPort < 0 || c.HTTPPort > 65535 {
+			return fmt.Errorf("%s must be a valid port number but was %d", "NEO4J_MCP_HTTP_PORT", c.HTTPPort)
+		}
+		if c.HTTPBasePath == "" || c.HTTPBasePath[0] != '/' {
+			return fmt.Errorf("%s must start with '/' but was %q", "NEO4J_MCP_HTTP_BASE_PATH", c.HTTPBasePath)
+		}
+	default:
+		return fmt.Errorf("%s must be one of %q or %q but was %q", "NEO4J_MCP_TRANSPORT", TransportStdio, TransportHTTP, c.Transport)
+	}
+
 	return nil
 }
 
-// LoadConfig loads configuration from environment variables with defaults
-func LoadConfig() (*Config, error) {
-	cfg := &Config{
-		URI:       GetEnvWithDefault("NEO4J_URI", "bolt://localhost:7687"),
-		Username:  GetEnvWithDefault("NEO4J_USERNAME", "neo4j"),
-		Password:  GetEnvWithDefault("NEO4J_PASSWORD", "password"),
-		Database:  GetEnvWithDefault("NEO4J_DATABASE", "neo4j"),
-		ReadOnly:  GetEnvWithDefault("NEO4J_READ_ONLY", "false"),
-		Telemetry: GetEnvWithDefault("NEO4J_TELEMETRY", "true"),
+// LoadConfig loads the configuration with the following precedence: command-line flags, environment variables, configuration file, defaults.
+// flags maps option keys (see Options) to the raw values given on the command line.
+// The configuration file is read from configFile, or from the path in NEO4J_MCP_CONFIG when configFile is empty;
+// no file is read when both are empty.
+func LoadConfig(configFile string, flags map[string]string) (*Config, error) {
+	cfg := &Config{}
+	for _, opt := range options {
+		if err := opt.set(cfg, opt.Default); err != nil {
+			return nil, fmt.Errorf("invalid default for %s: %w", opt.Key, err)
+		}
+	}
+
+	if configFile == "" {
+		configFile = os.Getenv(ConfigFileEnv)
+	}
+	if configFile != "" {
+		values, err := readConfigFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := cfg.applyFile(values); err != nil {
+			return nil, fmt.Errorf("invalid configuration file %s: %w", configFile, err)
+		}
+	}
+
+	for _, opt := range options {
+		if value := os.Getenv(opt.Env); value != "" {
+			if err := opt.set(cfg, value); err != nil {
+				return nil, fmt.Errorf("invalid configuration: %s %w", opt.Env, err)
+			}
+		}
+	}
+
+	for key := range flags {
+		if _, ok := optionByKey(key); !ok {
+			return nil, fmt.Errorf("invalid configuration: unknown option %s", key)
+		}
+	}
+	for _, opt := range options {
+		if value, ok := flags[opt.Key]; ok {
+			if err := opt.set(cfg, value); err != nil {
+				return nil, fmt.Errorf("invalid configuration: --%s %w", opt.Flag(), err)
+			}
+		}
 	}
 
 	if err := cfg.Validate(); err != nil {
@@ -61,6 +268,27 @@ func LoadConfig() (*Config, error) {
 	return cfg, nil
 }
 
+// applyFile sets the options found in a configuration file, recording the keys that do not match any option
+func (c *Config) applyFile(values map[string]string) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		opt, ok := optionByKey(key)
+		if !ok {
+			c.unknownKeys = append(c.unknownKeys, key)
+			continue
+		}
+		if err := opt.set(c, values[key]); err != nil {
+			return fmt.Errorf("%s %w", key, err)
+		}
+	}
+	return nil
+}
+
 func GetEnvWithDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -1,8 +1,12 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestConfig_Validate(t *testing.T) {
@@ -15,7 +19,7 @@ func TestConfig_Validate(t *testing.T) {
 		{
 			name: "valid config",
 			cfg: &Config{
-				Telemetry: "true",
+				Telemetry: true,
 				URI:       "bolt://localhost:7687",
 				Username:  "neo4j",
 				Password:  "password",
@@ -32,7 +36,7 @@ func TestConfig_Validate(t *testing.T) {
 		{
 			name: "empty URI",
 			cfg: &Config{
-				Telemetry: "true",
+				Telemetry: true,
 				URI:       "",
 				Username:  "neo4j",
 				Password:  "password",
@@ -44,7 +48,7 @@ func TestConfig_Validate(t *testing.T) {
 		{
 			name: "empty username",
 			cfg: &Config{
-				Telemetry: "true",
+				Telemetry: true,
 				URI:       "bolt://localhost:7687",
 				Username:  "",
 				Password:  "password",
@@ -56,7 +60,7 @@ func TestConfig_Validate(t *testing.T) {
 		{
 			name: "empty password",
 			cfg: &Config{
-				Telemetry: "true",
+				Telemetry: true,
 				URI:       "bolt://localhost:7687",
 				Username:  "neo4j",
 				Password:  "",
@@ -68,7 +72,7 @@ func TestConfig_Validate(t *testing.T) {
 		{
 			name: "empty database should not raise error",
 			cfg: &Config{
-				Telemetry: "true",
+				Telemetry: true,
 				URI:       "bolt://localhost:7687",
 				Username:  "neo4j",
 				Password:  "password",
@@ -78,26 +82,172 @@ func TestConfig_Validate(t *testing.T) {
 			errMsg:  "",
 		},
 		{
-			name: "Invalid NEO4J_TELEMETRY type",
+			name: "telemetry disabled",
 			cfg: &Config{
-				Telemetry: "falsy",
+				Telemetry: false,
 				URI:       "bolt://localhost:7687",
 				Username:  "neo4j",
 				Password:  "password",
 			},
+			wantErr: false,
+			errMsg:  "",
+		},
+		{
+			name: "valid http transport",
+			cfg: &Config{
+				Telemetry:    true,
+				URI:          "bolt://localhost:7687",
+				Username:     "neo4j",
+				Password:     "password",
+				Transport:    "http",
+				HTTPHost:     "127.0.0.1",
+				HTTPPort:     8080,
+				HTTPBasePath: "/mcp",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid transport",
+			cfg: &Config{
+				Telemetry: true,
+				URI:       "bolt://localhost:7687",
+				Username:  "neo4j",
+				Password:  "password",
+				Transport: "websocket",
+			},
+			wantErr: true,
+			errMsg:  "NEO4J_MCP_TRANSPORT must be one of",
+		},
+		{
+			name: "invalid schema strategy",
+			cfg: &Config{
+				URI:            "bolt://localhost:7687",
+				Username:       "neo4j",
+				Password:       "password",
+				SchemaStrategy: "sampling",
+			},
 			wantErr: true,
-			errMsg:  "NEO4J_TELEMETRY cannot be converted to type bool",
+			errMsg:  "NEO4J_MCP_SCHEMA_STRATEGY must be one of",
 		},
 		{
-			name: "Correct NEO4J_TELEMETRY type",
+			name: "invalid result format",
+			cfg: &Config{
+				URI:          "bolt://localhost:7687",
+				Username:     "neo4j",
+				Password:     "password",
+				ResultFormat: "xml",
+			},
+			wantErr: true,
+			errMsg:  "NEO4J_MCP_RESULT_FORMAT must be one of",
+		},
+		{
+			name: "negative max rows",
+			cfg: &Config{
+				URI:      "bolt://localhost:7687",
+				Username: "neo4j",
+				Password: "password",
+				MaxRows:  -1,
+			},
+			wantErr: true,
+			errMsg:  "NEO4J_MCP_MAX_ROWS must not be negative",
+		},
+		{
+			name: "negative max tokens",
 			cfg: &Config{
-				Telemetry: "true",
 				URI:       "bolt://localhost:7687",
 				Username:  "neo4j",
 				Password:  "password",
+				MaxTokens: -1,
 			},
-			wantErr: false,
-			errMsg:  "",
+			wantErr: true,
+			errMsg:  "NEO4J_MCP_MAX_TOKENS must not be negative",
+		},
+		{
+			name: "query timeout above the maximum",
+			cfg: &Config{
+				URI:             "bolt://localhost:7687",
+				Username:        "neo4j",
+				Password:        "password",
+				QueryTimeout:    time.Minute,
+				MaxQueryTimeout: time.Second,
+			},
+			wantErr: true,
+			errMsg:  "NEO4J_MCP_QUERY_TIMEOUT must not exceed NEO4J_MCP_MAX_QUERY_TIMEOUT",
+		},
+		{
+			name: "negative cursor ttl",
+			cfg: &Config{
+				URI:       "bolt://localhost:7687",
+				Username:  "neo4j",
+				Password:  "password",
+				CursorTTL: -time.Second,
+			},
+			wantErr: true,
+			errMsg:  "NEO4J_MCP_CURSOR_TTL must not be negative",
+		},
+		{
+			name: "out of range http port",
+			cfg: &Config{
+				Telemetry:    true,
+				URI:          "bolt://localhost:7687",
+				Username:     "neo4j",
+				Password:     "password",
+				Transport:    "http",
+				HTTPPort:     70000,
+				HTTPBasePath: "/mcp",
+			},
+			wantErr: true,
+			errMsg:  "NEO4J_MCP_HTTP_PORT must be a valid port number",
+		},
+		{
+			name: "http base path without leading slash",
+			cfg: &Config{
+				Telemetry:    true,
+				URI:          "bolt://localhost:7687",
+				Username:     "neo4j",
+				Password:     "password",
+				Transport:    "http",
+				HTTPPort:     8080,
+				HTTPBasePath: "mcp",
+			},
+			wantErr: true,
+			errMsg:  "NEO4J_MCP_HTTP_BASE_PATH must start with '/'",
+		},
+		{
+			name: "api-key authentication without keys file",
+			cfg: &Config{
+				Telemetry: true,
+				URI:       "bolt://localhost:7687",
+				Username:  "neo4j",
+				Password:  "password",
+				HTTPAuth:  "api-key",
+			},
+			wantErr: true,
+			errMsg:  "NEO4J_MCP_HTTP_API_KEYS_FILE is required",
+		},
+		{
+			name: "jwt authentication without key file",
+			cfg: &Config{
+				Telemetry: true,
+				URI:       "bolt://localhost:7687",
+				Username:  "neo4j",
+				Password:  "password",
+				HTTPAuth:  "jwt",
+			},
+			wantErr: true,
+			errMsg:  "NEO4J_MCP_HTTP_JWT_KEY_FILE is required",
+		},
+		{
+			name: "unknown authentication method",
+			cfg: &Config{
+				Telemetry: true,
+				URI:       "bolt://localhost:7687",
+				Username:  "neo4j",
+				Password:  "password",
+				HTTPAuth:  "oauth",
+			},
+			wantErr: true,
+			errMsg:  "NEO4J_MCP_HTTP_AUTH must be one of",
 		},
 	}
 
@@ -126,7 +276,7 @@ func TestConfig_Validate(t *testing.T) {
 func TestLoadConfig(t *testing.T) {
 	// Test LoadConfig with current environment (whatever it is)
 	// We don't modify environment variables to avoid parallel test issues
-	cfg, err := LoadConfig()
+	cfg, err := LoadConfig("", nil)
 
 	if err != nil {
 		// If LoadConfig fails, it means the current environment has invalid config
@@ -167,3 +317,215 @@ func TestLoadConfig(t *testing.T) {
 		t.Error("LoadConfig() returned empty database")
 	}
 }
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	return path
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	formats := map[string]string{
+		"config.yaml": "uri: bolt://file-host:7687\nread_only: true\ntelemetry: false\nhttp_port: 9090\n",
+		"config.toml": "uri = \"bolt://file-host:7687\"\nread_only = true\ntelemetry = false\nhttp_port = 9090\n",
+		"config.json": `{"uri": "bolt://file-host:7687", "read_only": true, "telemetry": false, "http_port": 9090}`,
+	}
+
+	for name, content := range formats {
+		t.Run("reads "+name, func(t *testing.T) {
+			cfg, err := LoadConfig(writeConfigFile(t, name, content), nil)
+			if err != nil {
+				t.Fatalf("LoadConfig() unexpected error = %v", err)
+			}
+			if cfg.URI != "bolt://file-host:7687" || !cfg.ReadOnly || cfg.Telemetry || cfg.HTTPPort != 9090 {
+				t.Errorf("LoadConfig() = %+v, want values from the file", cfg)
+			}
+			if cfg.Username != "neo4j" {
+				t.Errorf("LoadConfig() username = %q, want default %q", cfg.Username, "neo4j")
+			}
+		})
+	}
+
+	t.Run("environment variables take precedence over the file", func(t *testing.T) {
+		t.Setenv("NEO4J_URI", "bolt://env-host:7687")
+		t.Setenv("NEO4J_READ_ONLY", "false")
+
+		cfg, err := LoadConfig(writeConfigFile(t, "config.yaml", formats["config.yaml"]), nil)
+		if err != nil {
+			t.Fatalf("LoadConfig() unexpected error = %v", err)
+		}
+		if cfg.URI != "bolt://env-host:7687" || cfg.ReadOnly {
+			t.Errorf("LoadConfig() = %+v, want values from the environment", cfg)
+		}
+	})
+
+	t.Run("reads the file from NEO4J_MCP_CONFIG", func(t *testing.T) {
+		t.Setenv(ConfigFileEnv, writeConfigFile(t, "config.yaml", "database: movies\n"))
+
+		cfg, err := LoadConfig("", nil)
+		if err != nil {
+			t.Fatalf("LoadConfig() unexpected error = %v", err)
+		}
+		if cfg.Database != "movies" {
+			t.Errorf("LoadConfig() database = %q, want %q", cfg.Database, "movies")
+		}
+	})
+
+	t.Run("rejects unknown keys", func(t *testing.T) {
+		_, err := LoadConfig(writeConfigFile(t, "config.yaml", "uri: bolt://localhost:7687\nreadonly: true\npasword: secret\n"), nil)
+		if err == nil || !strings.Contains(err.Error(), "unknown configuration file keys: pasword, readonly") {
+			t.Errorf("LoadConfig() error = %v, want unknown keys error", err)
+		}
+	})
+
+	t.Run("rejects values of the wrong type", func(t *testing.T) {
+		_, err := LoadConfig(writeConfigFile(t, "config.yaml", "read_only: maybe\n"), nil)
+		if err == nil || !strings.Contains(err.Error(), "read_only cannot be converted to type bool") {
+			t.Errorf("LoadConfig() error = %v, want type error", err)
+		}
+	})
+
+	t.Run("rejects nested values", func(t *testing.T) {
+		_, err := LoadConfig(writeConfigFile(t, "config.yaml", "http:\n  port: 8080\n"), nil)
+		if err == nil || !strings.Contains(err.Error(), "must be a scalar or a list") {
+			t.Errorf("LoadConfig() error = %v, want nested value error", err)
+		}
+	})
+
+	t.Run("rejects unsupported extensions", func(t *testing.T) {
+		_, err := LoadConfig(writeConfigFile(t, "config.ini", "uri=bolt://localhost:7687\n"), nil)
+		if err == nil || !strings.Contains(err.Error(), "unsupported configuration file extension") {
+			t.Errorf("LoadConfig() error = %v, want extension error", err)
+		}
+	})
+
+	t.Run("rejects a missing file", func(t *testing.T) {
+		if _, err := LoadConfig(filepath.Join(t.TempDir(), "missing.yaml"), nil); err == nil {
+			t.Error("LoadConfig() expected error for missing file")
+		}
+	})
+
+	t.Run("rejects invalid environment values", func(t *testing.T) {
+		t.Setenv("NEO4J_TELEMETRY", "falsy")
+
+		_, err := LoadConfig("", nil)
+		if err == nil || !strings.Contains(err.Error(), "NEO4J_TELEMETRY cannot be converted to type bool") {
+			t.Errorf("LoadConfig() error = %v, want type error", err)
+		}
+	})
+}
+
+func TestLoadConfigFlags(t *testing.T) {
+	t.Run("flags take precedence over environment variables and the file", func(t *testing.T) {
+		t.Setenv("NEO4J_URI", "bolt://env-host:7687")
+		configFile := writeConfigFile(t, "config.yaml", "uri: bolt://file-host:7687\ndatabase: movies\nread_only: false\n")
+
+		cfg, err := LoadConfig(configFile, map[string]string{"uri": "bolt://flag-host:7687", "read_only": "true"})
+		if err != nil {
+			t.Fatalf("LoadConfig() unexpected error = %v", err)
+		}
+		if cfg.URI != "bolt://flag-host:7687" || !cfg.ReadOnly {
+			t.Errorf("LoadConfig() = %+v, want values from the flags", cfg)
+		}
+		if cfg.Database != "movies" {
+			t.Errorf("LoadConfig() database = %q, want value from the file", cfg.Database)
+		}
+	})
+
+	t.Run("rejects invalid flag values", func(t *testing.T) {
+		_, err := LoadConfig("", map[string]string{"http_port": "eighty"})
+		if err == nil || !strings.Contains(err.Error(), "--http-port cannot be converted to type int") {
+			t.Errorf("LoadConfig() error = %v, want type error", err)
+		}
+	})
+
+	t.Run("parses durations", func(t *testing.T) {
+		cfg, err := LoadConfig("", map[string]string{"cursor_ttl": "90s"})
+		if err != nil {
+			t.Fatalf("LoadConfig() unexpected error = %v", err)
+		}
+		if cfg.CursorTTL != 90*time.Second {
+			t.Errorf("LoadConfig() cursor TTL = %s, want 1m30s", cfg.CursorTTL)
+		}
+
+		_, err = LoadConfig("", map[string]string{"cursor_ttl": "5"})
+		if err == nil || !strings.Contains(err.Error(), "--cursor-ttl cannot be converted to type duration") {
+			t.Errorf("LoadConfig() error = %v, want type error", err)
+		}
+	})
+
+	t.Run("rejects unknown options", func(t *testing.T) {
+		if _, err := LoadConfig("", map[string]string{"nope": "1"}); err == nil {
+			t.Error("LoadConfig() expected error for unknown option")
+		}
+	})
+}
+
+func TestOptions(t *testing.T) {
+	cfg := &Config{}
+	for _, opt := range Options() {
+		if opt.Key == "" || opt.Env == "" || opt.Usage == "" {
+			t.Errorf("option %+v must have a key, an environment variable and a usage", opt)
+		}
+		if opt.Type != TypeString && opt.Type != TypeBool && opt.Type != TypeInt && opt.Type != TypeList && opt.Type != TypeDuration {
+			t.Errorf("option %s has unknown type %q", opt.Key, opt.Type)
+		}
+		if err := opt.set(cfg, opt.Default); err != nil && opt.Default != "" {
+			t.Errorf("option %s has invalid default %q: %v", opt.Key, opt.Default, err)
+		}
+	}
+}
+
+func TestDatabaseAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		db      string
+		want    bool
+	}{
+		{name: "default database", db: "neo4j", want: true},
+		{name: "default database is case-insensitive", db: "Neo4j", want: true},
+		{name: "other database without allowlist", db: "movies", want: false},
+		{name: "listed database", allowed: []string{"movies", "people"}, db: "people", want: true},
+		{name: "listed database is case-insensitive", allowed: []string{"movies"}, db: "MOVIES", want: true},
+		{name: "unlisted database", allowed: []string{"movies"}, db: "system", want: false},
+		{name: "wildcard", allowed: []string{AllowAnyDatabase}, db: "anything", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Database: "neo4j", AllowedDatabases: tt.allowed}
+			if got := cfg.DatabaseAllowed(tt.db); got != tt.want {
+				t.Errorf("DatabaseAllowed(%q) = %v, want %v", tt.db, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadConfigAllowedDatabases(t *testing.T) {
+	t.Setenv("NEO4J_ALLOWED_DATABASES", " movies, people ,,")
+
+	cfg, err := LoadConfig("", nil)
+	if err != nil {
+		t.Fatalf("LoadConfig() unexpected error: %v", err)
+	}
+	want := []string{"movies", "people"}
+	if !reflect.DeepEqual(cfg.AllowedDatabases, want) {
+		t.Errorf("AllowedDatabases = %v, want %v", cfg.AllowedDatabases, want)
+	}
+
+	// an empty environment variable leaves the configuration file value
+	t.Setenv("NEO4J_ALLOWED_DATABASES", "")
+	path := writeConfigFile(t, "config.yaml", "allowed_databases: [a, b]\n")
+	cfg, err = LoadConfig(path, nil)
+	if err != nil {
+		t.Fatalf("LoadConfig() unexpected error: %v", err)
+	}
+	want = []string{"a", "b"}
+	if !reflect.DeepEqual(cfg.AllowedDatabases, want) {
+		t.Errorf("AllowedDatabases = %v, want %v", cfg.AllowedDatabases, want)
+	}
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// readConfigFile reads a YAML, TOML or JSON configuration file, selected by its extension,
+// and returns its top-level keys with their values converted to strings
+func readConfigFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path comes from the command line or environment
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file: %w", err)
+	}
+
+	raw := make(map[string]any)
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &raw)
+	case ".toml":
+		err = toml.Unmarshal(data, &raw)
+	case ".json":
+		err = json.Unmarshal(data, &raw)
+	default:
+		return nil, fmt.Errorf("unsupported configuration file extension %q, use .yaml, .yml, .toml or .json", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse configuration file %s: %w", path, err)
+	}
+
+	values := make(map[string]string, len(raw))
+	for key, value := range raw {
+		s, err := stringValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration file %s: %s %w", path, key, err)
+		}
+		values[key] = s
+	}
+	return values, nil
+}
+
+// stringValue converts a scalar or list value of a configuration file to the string syntax used by environment variables
+func stringValue(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool, int, int64, uint64:
+		return fmt.Sprint(v), nil
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			s, err := stringValue(item)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, s)
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return "", fmt.Errorf("must be a scalar or a list but was %T", value)
+	}
+}
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Option value types, used to parse command-line flags and document options
+const (
+	TypeString   = "string"
+	TypeBool     = "bool"
+	TypeInt      = "int"
+	TypeList     = "list"     // comma-separated list of strings
+	TypeDuration = "duration" // duration such as 30s or 5m
+)
+
+// Option describes a configuration option, settable from a command-line flag, an environment variable and the configuration file
+type Option struct {
+	Key     string // key in the configuration file
+	Env     string // environment variable
+	Type    string // one of TypeString, TypeBool, TypeInt, TypeList or TypeDuration
+	Default string
+	Usage   string
+	set     func(c *Config, value string) error
+}
+
+// Flag returns the name of the command-line flag setting the option, e.g. "read-only" for the "read_only" key
+func (o Option) Flag() string {
+	return strings.ReplaceAll(o.Key, "_", "-")
+}
+
+// Options returns every configuration option, in the order they are documented
+func Options() []Option {
+	return slices.Clone(options)
+}
+
+var options = []Option{
+	{Key: "uri", Env: "NEO4J_URI", Type: TypeString, Default: "bolt://localhost:7687", Usage: "Neo4j database URI",
+		set: func(c *Config, v string) error { c.URI = v; return nil }},
+	{Key: "username", Env: "NEO4J_USERNAME", Type: TypeString, Default: "neo4j", Usage: "Database username",
+		set: func(c *Config, v string) error { c.Username = v; return nil }},
+	{Key: "password", Env: "NEO4J_PASSWORD", Type: TypeString, Default: "password", Usage: "Database password, discouraged as a flag since it shows in ps and shell history: prefer NEO4J_PASSWORD or the configuration file",
+		set: func(c *Config, v string) error { c.Password = v; return nil }},
+	{Key: "database", Env: "NEO4J_DATABASE", Type: TypeString, Default: "neo4j", Usage: "Database name",
+		set: func(c *Config, v string) error { c.Database = v; return nil }},
+	{Key: "allowed_databases", Env: "NEO4J_ALLOWED_DATABASES", Type: TypeList, Usage: "Databases tools may target besides the default one, '*' allows any",
+		set: listSetter(func(c *Config) *[]string { return &c.AllowedDatabases })},
+	{Key: "max_rows", Env: "NEO4J_MCP_MAX_ROWS", Type: TypeInt, Default: "1000", Usage: "Maximum number of records returned by read-cypher, 0 disables the limit",
+		set: intSetter(func(c *Config) *int { return &c.MaxRows })},
+	{Key: "max_response_bytes", Env: "NEO4J_MCP_MAX_RESPONSE_BYTES", Type: TypeInt, Default: "262144", Usage: "Approximate maximum size in bytes of the records returned by read-cypher, 0 disables the limit",
+		set: intSetter(func(c *Config) *int { return &c.MaxResponseBytes })},
+	{Key: "result_format", Env: "NEO4J_MCP_RESULT_FORMAT", Type: TypeString, Default: ResultFormatJSON, Usage: "Default format of the records returned by read-cypher and write-cypher: json, json-compact, csv or markdown",
+		set: func(c *Config, v string) error { c.ResultFormat = v; return nil }},
+	{Key: "max_tokens", Env: "NEO4J_MCP_MAX_TOKENS", Type: TypeInt, Default: "0", Usage: "Default approximate token budget of the records returned by read-cypher and write-cypher, 0 disables it",
+		set: intSetter(func(c *Config) *int { return &c.MaxTokens })},
+	{Key: "vector_min_dimensions", Env: "NEO4J_MCP_VECTOR_MIN_DIMENSIONS", Type: TypeInt, Default: "128", Usage: "Minimum length of the numeric lists returned as a vector placeholder unless raw vectors are requested, 0 disables the detection",
+		set: intSetter(func(c *Config) *int { return &c.VectorMinDimensions })},
+	{Key: "vector_properties", Env: "NEO4J_MCP_VECTOR_PROPERTIES", Type: TypeList, Usage: "Properties whose numeric lists are always returned as a vector placeholder unless raw vectors are requested",
+		set: listSetter(func(c *Config) *[]string { return &c.VectorProperties })},
+	{Key: "embedding_provider", Env: "NEO4J_MCP_EMBEDDING_PROVIDER", Type: TypeString, Usage: "Provider computing the vectors of the texts searched by vector-search, e.g. ollama, empty disables text searches",
+		set: func(c *Config, v string) error { c.EmbeddingProvider = v; return nil }},
+	{Key: "embedding_url", Env: "NEO4J_MCP_EMBEDDING_URL", Type: TypeString, Usage: "Endpoint of the embedding provider, the provider default when empty",
+		set: func(c *Config, v string) error { c.EmbeddingURL = v; return nil }},
+	{Key: "embedding_model", Env: "NEO4J_MCP_EMBEDDING_MODEL", Type: TypeString, Usage: "Embedding model, the one the vectors of the vector indexes were computed with",
+		set: func(c *Config, v string) error { c.EmbeddingModel = v; return nil }},
+	{Key: "query_timeout", Env: "NEO4J_MCP_QUERY_TIMEOUT", Type: TypeDuration, Default: "30s", Usage: "Default timeout of the transactions run by tools, 0 uses the database setting",
+		set: durationSetter(func(c *Config) *time.Duration { return &c.QueryTimeout })},
+	{Key: "max_query_timeout", Env: "NEO4J_MCP_MAX_QUERY_TIMEOUT", Type: TypeDuration, Default: "5m", Usage: "Maximum timeout tools may request per call, 0 disables the limit",
+		set: durationSetter(func(c *Config) *time.Duration { return &c.MaxQueryTimeout })},
+	{Key: "cursor_ttl", Env: "NEO4J_MCP_CURSOR_TTL", Type: TypeDuration, Default: "5m", Usage: "Time a truncated read-cypher result can be paginated with fetch-more, 0 disables pagination",
+		set: durationSetter(func(c *Config) *time.Duration { return &c.CursorTTL })},
+	{Key: "max_cursors_per_session", Env: "NEO4J_MCP_MAX_CURSORS_PER_SESSION", Type: TypeInt, Default: "10", Usage: "Maximum number of paginated results per client session, the oldest is closed past it",
+		set: intSetter(func(c *Config) *int { return &c.MaxCursorsPerSession })},
+	{Key: "schema_strategy", Env: "NEO4J_MCP_SCHEMA_STRATEGY", Type: TypeString, Default: SchemaStrategyAuto, Usage: "How get-schema introspects the database: auto, apoc or native",
+		set: func(c *Config, v string) error { c.SchemaStrategy = v; return nil }},
+	{Key: "schema_cache_ttl", Env: "NEO4J_MCP_SCHEMA_CACHE_TTL", Type: TypeDuration, Default: "5m", Usage: "Time get-schema results are cached, 0 disables the cache",
+		set: durationSetter(func(c *Config) *time.Duration { return &c.SchemaCacheTTL })},
+	{Key: "read_only", Env: "NEO4J_READ_ONLY", Type: TypeBool, Default: "false", Usage: "Disable write tools",
+		set: boolSetter(func(c *Config) *bool { return &c.ReadOnly })},
+	{Key: "telemetry", Env: "NEO4J_TELEMETRY", Type: TypeBool, Default: "true", Usage: "Send anonymous usage data",
+		set: boolSetter(func(c *Config) *bool { return &c.Telemetry })},
+	{Key: "transport", Env: "NEO4J_MCP_TRANSPORT", Type: TypeString, Default: TransportStdio, Usage: "MCP transport, stdio or http",
+		set: func(c *Config, v string) error { c.Transport = v; return nil }},
+	{Key: "http_host", Env: "NEO4J_MCP_HTTP_HOST", Type: TypeString, Default: "127.0.0.1", Usage: "Host the HTTP transport binds to",
+		set: func(c *Config, v string) error { c.HTTPHost = v; return nil }},
+	{Key: "http_port", Env: "NEO4J_MCP_HTTP_PORT", Type: TypeInt, Default: "8080", Usage: "Port the HTTP transport listens on",
+		set: intSetter(func(c *Config) *int { return &c.HTTPPort })},
+	{Key: "http_base_path", Env: "NEO4J_MCP_HTTP_BASE_PATH", Type: TypeString, Default: "/mcp", Usage: "Path the MCP endpoint is served under",
+		set: func(c *Config, v string) error { c.HTTPBasePath = v; return nil }},
+	{Key: "http_per_request_auth", Env: "NEO4J_MCP_HTTP_PER_REQUEST_AUTH", Type: TypeBool, Default: "false", Usage: "Run each HTTP request as the Neo4j user from its Authorization header",
+		set: boolSetter(func(c *Config) *bool { return &c.HTTPPerRequestAuth })},
+	{Key: "http_auth", Env: "NEO4J_MCP_HTTP_AUTH", Type: TypeString, Default: HTTPAuthNone, Usage: "Authentication of HTTP clients: none, api-key or jwt",
+		set: func(c *Config, v string) error { c.HTTPAuth = v; return nil }},
+	{Key: "http_api_keys_file", Env: "NEO4J_MCP_HTTP_API_KEYS_FILE", Type: TypeString, Usage: "File holding the accepted API keys",
+		set: func(c *Config, v string) error { c.HTTPAPIKeysFile = v; return nil }},
+	{Key: "http_jwt_key_file", Env: "NEO4J_MCP_HTTP_JWT_KEY_FILE", Type: TypeString, Usage: "JWKS or PEM file holding the JWT verification keys",
+		set: func(c *Config, v string) error { c.HTTPJWTKeyFile = v; return nil }},
+	{Key: "http_jwt_issuer", Env: "NEO4J_MCP_HTTP_JWT_ISSUER", Type: TypeString, Usage: "Required JWT issuer",
+		set: func(c *Config, v string) error { c.HTTPJWTIssuer = v; return nil }},
+	{Key: "http_jwt_audience", Env: "NEO4J_MCP_HTTP_JWT_AUDIENCE", Type: TypeString, Usage: "Required JWT audience",
+		set: func(c *Config, v string) error { c.HTTPJWTAudience = v; return nil }},
+}
+
+// optionByKey returns the option with the given configuration file key
+func optionByKey(key string) (Option, bool) {
+	for _, opt := range options {
+		if opt.Key == key {
+			return opt, true
+		}
+	}
+	return Option{}, false
+}
+
+func boolSetter(field func(c *Config) *bool) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("cannot be converted to type %s", "bool")
+		}
+		*field(c) = b
+		return nil
+	}
+}
+
+func intSetter(field func(c *Config) *int) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("cannot be converted to type %s", "int")
+		}
+		*field(c) = i
+		return nil
+	}
+}
+
+func durationSetter(field func(c *Config) *time.Duration) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("cannot be converted to type %s", "duration")
+		}
+		*field(c) = d
+		return nil
+	}
+}
+
+func listSetter(field func(c *Config) *[]string) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		list := make([]string, 0)
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		*field(c) = list
+		return nil
+	}
+}
@@ -0,0 +1,149 @@
+// Package cursor keeps track of the paginated query results clients can fetch the next page of.
+package cursor
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for cursors that never existed, expired or belong to another session
+var ErrNotFound = errors.New("cursor not found or expired")
+
+// Cursor holds what is needed to fetch the next page of a query result
+type Cursor struct {
+	Query     string
+	Params    map[string]any
+	Database  string   // database the query runs against, empty for the default database
+	Offset    int      // number of records already returned
+	Bookmarks []string // bookmarks of the previous page, so the next one reads at least as recent data
+	Format    string   // format of the records, as requested when the query was run
+	MaxTokens int      // token budget of each page, 0 when there is none
+	Timeout   float64  // timeout of each page in seconds, as requested when the query was run, 0 for the default one
+	// RawVectors tells whether embedding vectors are returned as is, as requested when the query was run
+	RawVectors bool
+}
+
+type entry struct {
+	cursor  Cursor
+	seq     uint64 // order in which cursors were opened
+	expires time.Time
+}
+
+// Store holds the open cursors of every client session.
+// Cursors expire after their time to live and each session holds at most a fixed number of them,
+// opening a cursor past that number closes the oldest one of the session.
+type Store struct {
+	ttl           time.Duration
+	maxPerSession int
+	now           func() time.Time
+
+	mu       sync.Mutex
+	seq      uint64
+	sessions map[string]map[string]*entry
+}
+
+// NewStore creates a Store whose cursors expire after ttl, holding at most maxPerSession cursors per client session
+func NewStore(ttl time.Duration, maxPerSession int) *Store {
+	return &Store{
+		ttl:           ttl,
+		maxPerSession: maxPerSession,
+		now:           time.Now,
+		sessions:      make(map[string]map[string]*entry),
+	}
+}
+
+// Open stores c for the given client session and returns its ID
+func (s *Store) Open(sessionID string, c Cursor) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.removeExpired(now)
+
+	cursors, ok := s.sessions[sessionID]
+	if !ok {
+		cursors = make(map[string]*entry)
+		s.sessions[sessionID] = cursors
+	}
+	for s.maxPerSession > 0 && len(cursors) >= s.maxPerSession {
+		delete(cursors, oldest(cursors))
+	}
+
+	s.seq++
+	id := uuid.NewString()
+	cursors[id] = &entry{cursor: c, seq: s.seq, expires: now.Add(s.ttl)}
+	return id
+}
+
+// Get returns the cursor with the given ID, if it is open in the given client session
+func (s *Store) Get(sessionID, id string) (Cursor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeExpired(s.now())
+
+	e, ok := s.sessions[sessionID][id]
+	if !ok {
+		return Cursor{}, ErrNotFound
+	}
+	return e.cursor, nil
+}
+
+// Update replaces the cursor with the given ID after a page was fetched and extends its expiry
+func (s *Store) Update(sessionID, id string, c Cursor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.removeExpired(now)
+
+	e, ok := s.sessions[sessionID][id]
+	if !ok {
+		return ErrNotFound
+	}
+	e.cursor = c
+	e.expires = now.Add(s.ttl)
+	return nil
+}
+
+// Close removes the cursor with the given ID, once its last page was fetched
+func (s *Store) Close(sessionID, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cursors, ok := s.sessions[sessionID]; ok {
+		delete(cursors, id)
+		if len(cursors) == 0 {
+			delete(s.sessions, sessionID)
+		}
+	}
+}
+
+// removeExpired removes the cursors expired at now; s.mu must be held
+func (s *Store) removeExpired(now time.Time) {
+	for sessionID, cursors := range s.sessions {
+		for id, e := range cursors {
+			if !now.Before(e.expires) {
+				delete(cursors, id)
+			}
+		}
+		if len(cursors) == 0 {
+			delete(s.sessions, sessionID)
+		}
+	}
+}
+
+// oldest returns the ID of the cursor opened first
+func oldest(cursors map[string]*entry) string {
+	var id string
+	var seq uint64
+	for candidate, e := range cursors {
+		if id == "" || e.seq < seq {
+			id, seq = candidate, e.seq
+		}
+	}
+	return id
+}
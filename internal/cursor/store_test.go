@@ -0,0 +1,103 @@
+package cursor
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStore(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newStore := func(maxPerSession int) *Store {
+		s := NewStore(time.Minute, maxPerSession)
+		s.now = func() time.Time { return now }
+		return s
+	}
+
+	t.Run("open and get", func(t *testing.T) {
+		s := newStore(0)
+		id := s.Open("session", Cursor{Query: "MATCH (n) RETURN n", Offset: 10})
+
+		c, err := s.Get("session", id)
+		if err != nil {
+			t.Fatalf("Get() unexpected error: %v", err)
+		}
+		if c.Query != "MATCH (n) RETURN n" || c.Offset != 10 {
+			t.Errorf("Get() = %+v, want the opened cursor", c)
+		}
+	})
+
+	t.Run("cursors belong to their session", func(t *testing.T) {
+		s := newStore(0)
+		id := s.Open("session", Cursor{})
+
+		if _, err := s.Get("other", id); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Get() from another session error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("cursors expire", func(t *testing.T) {
+		s := newStore(0)
+		id := s.Open("session", Cursor{})
+
+		now = now.Add(time.Minute)
+		if _, err := s.Get("session", id); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Get() after expiry error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("update extends expiry", func(t *testing.T) {
+		s := newStore(0)
+		id := s.Open("session", Cursor{Offset: 10})
+
+		now = now.Add(30 * time.Second)
+		if err := s.Update("session", id, Cursor{Offset: 20}); err != nil {
+			t.Fatalf("Update() unexpected error: %v", err)
+		}
+		now = now.Add(45 * time.Second)
+		c, err := s.Get("session", id)
+		if err != nil {
+			t.Fatalf("Get() unexpected error: %v", err)
+		}
+		if c.Offset != 20 {
+			t.Errorf("Offset = %d, want 20", c.Offset)
+		}
+	})
+
+	t.Run("update unknown cursor", func(t *testing.T) {
+		s := newStore(0)
+		if err := s.Update("session", "unknown", Cursor{}); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Update() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("close", func(t *testing.T) {
+		s := newStore(0)
+		id := s.Open("session", Cursor{})
+		s.Close("session", id)
+
+		if _, err := s.Get("session", id); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Get() after Close() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("cap closes the oldest cursor of the session", func(t *testing.T) {
+		s := newStore(2)
+		first := s.Open("session", Cursor{})
+		second := s.Open("session", Cursor{})
+		other := s.Open("other", Cursor{})
+		third := s.Open("session", Cursor{})
+
+		if _, err := s.Get("session", first); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Get() of the oldest cursor error = %v, want ErrNotFound", err)
+		}
+		for _, id := range []string{second, third} {
+			if _, err := s.Get("session", id); err != nil {
+				t.Errorf("Get() unexpected error: %v", err)
+			}
+		}
+		if _, err := s.Get("other", other); err != nil {
+			t.Errorf("Get() of another session unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+type authTokenKey struct{}
+
+type databaseKey struct{}
+
+type txTimeoutKey struct{}
+
+type txMetadataKey struct{}
+
+// WithAuthToken returns a copy of ctx carrying Neo4j credentials.
+// Queries executed with the returned context run as the identity of the token instead of the driver's default credentials.
+func WithAuthToken(ctx context.Context, token neo4j.AuthToken) context.Context {
+	return context.WithValue(ctx, authTokenKey{}, token)
+}
+
+// AuthTokenFromContext returns the Neo4j credentials carried by ctx, if any
+func AuthTokenFromContext(ctx context.Context) (neo4j.AuthToken, bool) {
+	token, ok := ctx.Value(authTokenKey{}).(neo4j.AuthToken)
+	return token, ok
+}
+
+// WithDatabase returns a copy of ctx targeting the named database.
+// Queries executed with the returned context run against it instead of the service's default database.
+func WithDatabase(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, databaseKey{}, name)
+}
+
+// DatabaseFromContext returns the database targeted by ctx, if any
+func DatabaseFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(databaseKey{}).(string)
+	return name, ok && name != ""
+}
+
+// WithTxTimeout returns a copy of ctx setting the timeout of the transactions of the queries executed with it.
+// Neo4j terminates transactions running longer than the timeout.
+func WithTxTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, txTimeoutKey{}, timeout)
+}
+
+// TxTimeoutFromContext returns the transaction timeout carried by ctx, if any
+func TxTimeoutFromContext(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(txTimeoutKey{}).(time.Duration)
+	return timeout, ok
+}
+
+// WithTxMetadata returns a copy of ctx adding metadata to the transactions of the queries executed with it,
+// merged with the metadata already carried by ctx.
+// Transaction metadata is listed by SHOW TRANSACTIONS and in the query log.
+func WithTxMetadata(ctx context.Context, metadata map[string]any) context.Context {
+	merged := maps.Clone(TxMetadataFromContext(ctx))
+	if merged == nil {
+		merged = make(map[string]any, len(metadata))
+	}
+	maps.Copy(merged, metadata)
+	return context.WithValue(ctx, txMetadataKey{}, merged)
+}
+
+// TxMetadataFromContext returns the transaction metadata carried by ctx, nil when there is none
+func TxMetadataFromContext(ctx context.Context) map[string]any {
+	metadata, _ := ctx.Value(txMetadataKey{}).(map[string]any)
+	return metadata
+}
@@ -0,0 +1,316 @@
+package database_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// The driver's session, transaction and result interfaces have unexported methods and cannot be mocked with mockgen,
+// so these fakes embed them and implement only the methods used by Neo4jService.
+
+// fakeDriver opens fakeSessions returning the configured records
+type fakeDriver struct {
+	neo4j.DriverWithContext
+	keys    []string
+	records []*neo4j.Record
+	err     error
+	// bookmarks returned by the sessions once their transaction completed
+	bookmarks []string
+	// counters reported by the summary of the results
+	counters database.Counters
+	// statement type, plan and notifications reported by the summary of the results
+	statementType neo4j.StatementType
+	plan          neo4j.Plan
+	profile       neo4j.ProfiledPlan
+	notifications []neo4j.Notification
+
+	sessionConfig neo4j.SessionConfig
+	txConfig      neo4j.TransactionConfig
+	cypher        string
+	committed     bool
+	rolledBack    bool
+	// runCtx is the context the last query ran with
+	runCtx context.Context
+	// closed reports whether the last session was closed, with closeErr the error of its context at that time
+	// and closeDeadline whether that context had a deadline
+	closed        bool
+	closeErr      error
+	closeDeadline bool
+	// transientErrors is the number of runs failing with a transient error before the queries succeed
+	transientErrors int
+	// attempts counts the transactions begun
+	attempts int
+	// cancel, when set, is called once the first record was consumed, to cancel the query while its result streams
+	cancel context.CancelFunc
+}
+
+// bookmarkManager is the bookmark manager shared by the sessions of fakeDriver
+var bookmarkManager = neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{})
+
+func (d *fakeDriver) ExecuteQueryBookmarkManager() neo4j.BookmarkManager {
+	return bookmarkManager
+}
+
+func (d *fakeDriver) NewSession(_ context.Context, config neo4j.SessionConfig) neo4j.SessionWithContext {
+	d.sessionConfig = config
+	return &fakeSession{driver: d}
+}
+
+type fakeSession struct {
+	neo4j.SessionWithContext
+	driver *fakeDriver
+}
+
+func (s *fakeSession) BeginTransaction(_ context.Context, configurers ...func(*neo4j.TransactionConfig)) (neo4j.ExplicitTransaction, error) {
+	for _, configure := range configurers {
+		configure(&s.driver.txConfig)
+	}
+	s.driver.attempts++
+	return &fakeTransaction{driver: s.driver}, nil
+}
+
+// maxFakeAttempts bounds the attempts of the managed transactions of fakeSession, like the driver's retry timeout
+const maxFakeAttempts = 3
+
+func (s *fakeSession) ExecuteRead(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error) {
+	return s.execute(ctx, work, configurers...)
+}
+
+func (s *fakeSession) ExecuteWrite(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error) {
+	return s.execute(ctx, work, configurers...)
+}
+
+// execute runs work like the driver runs managed transactions: committed when it succeeds and retried when it fails
+// with a retryable error. Like the driver, it does not roll back a failed transaction: the connection is reset instead.
+func (s *fakeSession) execute(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error) {
+	for attempt := 1; ; attempt++ {
+		tx, _ := s.BeginTransaction(ctx, configurers...)
+		result, err := work(tx.(*fakeTransaction))
+		if err == nil {
+			return result, tx.Commit(ctx)
+		}
+		if !neo4j.IsRetryable(err) || attempt == maxFakeAttempts {
+			return nil, err
+		}
+	}
+}
+
+func (s *fakeSession) LastBookmarks() neo4j.Bookmarks {
+	return neo4j.BookmarksFromRawValues(s.driver.bookmarks...)
+}
+
+func (s *fakeSession) Close(ctx context.Context) error {
+	s.driver.closed, s.driver.closeErr = true, ctx.Err()
+	_, s.driver.closeDeadline = ctx.Deadline()
+	return nil
+}
+
+type fakeTransaction struct {
+	neo4j.ExplicitTransaction
+	driver *fakeDriver
+}
+
+func (tx *fakeTransaction) Run(ctx context.Context, cypher string, _ map[string]any) (neo4j.ResultWithContext, error) {
+	tx.driver.runCtx = ctx
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	tx.driver.cypher = cypher
+	if tx.driver.transientErrors > 0 {
+		tx.driver.transientErrors--
+		return nil, &neo4j.Neo4jError{Code: "Neo.TransientError.Transaction.DeadlockDetected", Msg: "deadlock detected"}
+	}
+	return &fakeResult{keys: tx.driver.keys, records: tx.driver.records, err: tx.driver.err, next: -1, cancel: tx.driver.cancel, summary: tx.driver.summary()}, nil
+}
+
+func (tx *fakeTransaction) Commit(context.Context) error {
+	tx.driver.committed = true
+	return nil
+}
+
+func (tx *fakeTransaction) Rollback(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	tx.driver.rolledBack = true
+	return nil
+}
+
+type fakeResult struct {
+	neo4j.ResultWithContext
+	keys    []string
+	records []*neo4j.Record
+	err     error
+	next    int
+	cancel  context.CancelFunc
+	summary *fakeSummary
+}
+
+func (r *fakeResult) Keys() ([]string, error) {
+	return r.keys, nil
+}
+
+func (r *fakeResult) Next(ctx context.Context) bool {
+	if r.next == 0 && r.cancel != nil {
+		r.cancel()
+	}
+	if ctx.Err() != nil {
+		r.err = ctx.Err()
+		r.next = len(r.records)
+		return false
+	}
+	if r.next+1 >= len(r.records) {
+		return false
+	}
+	r.next++
+	return true
+}
+
+func (r *fakeResult) Collect(ctx context.Context) ([]*neo4j.Record, error) {
+	records := make([]*neo4j.Record, 0)
+	for r.Next(ctx) {
+		records = append(records, r.Record())
+	}
+	return records, r.Err()
+}
+
+func (r *fakeResult) Record() *neo4j.Record {
+	return r.records[r.next]
+}
+
+func (r *fakeResult) Consume(ctx context.Context) (neo4j.ResultSummary, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.summary, nil
+}
+
+func (r *fakeResult) Err() error {
+	if r.next+1 >= len(r.records) {
+		return r.err
+	}
+	return nil
+}
+
+// fakeRecords returns count records with a single "n" column holding their index
+func fakeRecords(count int) []*neo4j.Record {
+	records := make([]*neo4j.Record, count)
+	for i := range records {
+		records[i] = &neo4j.Record{Keys: []string{"n"}, Values: []any{int64(i)}}
+	}
+	return records
+}
+
+// summary returns the summary of the results of d
+func (d *fakeDriver) summary() *fakeSummary {
+	return &fakeSummary{
+		counters:      fakeCounters{d.counters},
+		statementType: d.statementType,
+		plan:          d.plan,
+		profile:       d.profile,
+		notifications: d.notifications,
+	}
+}
+
+type fakeSummary struct {
+	neo4j.ResultSummary
+	counters      fakeCounters
+	statementType neo4j.StatementType
+	plan          neo4j.Plan
+	profile       neo4j.ProfiledPlan
+	notifications []neo4j.Notification
+}
+
+func (s *fakeSummary) Counters() neo4j.Counters {
+	return s.counters
+}
+
+func (s *fakeSummary) StatementType() neo4j.StatementType {
+	return s.statementType
+}
+
+func (s *fakeSummary) Plan() neo4j.Plan {
+	return s.plan
+}
+
+func (s *fakeSummary) ResultAvailableAfter() time.Duration {
+	return 2 * time.Millisecond
+}
+
+func (s *fakeSummary) ResultConsumedAfter() time.Duration {
+	return 3 * time.Millisecond
+}
+
+func (s *fakeSummary) Profile() neo4j.ProfiledPlan {
+	return s.profile
+}
+
+func (s *fakeSummary) Notifications() []neo4j.Notification {
+	return s.notifications
+}
+
+// fakePlan is an operator of a query plan
+type fakePlan struct {
+	operator    string
+	arguments   map[string]any
+	identifiers []string
+	children    []neo4j.Plan
+}
+
+func (p *fakePlan) Operator() string          { return p.operator }
+func (p *fakePlan) Arguments() map[string]any { return p.arguments }
+func (p *fakePlan) Identifiers() []string     { return p.identifiers }
+func (p *fakePlan) Children() []neo4j.Plan    { return p.children }
+
+// fakeProfiledPlan is an operator of an executed query plan
+type fakeProfiledPlan struct {
+	fakePlan
+	dbHits, records, pageCacheHits, pageCacheMisses, time int64
+	children                                              []neo4j.ProfiledPlan
+}
+
+func (p *fakeProfiledPlan) DbHits() int64          { return p.dbHits }
+func (p *fakeProfiledPlan) Records() int64         { return p.records }
+func (p *fakeProfiledPlan) PageCacheHits() int64   { return p.pageCacheHits }
+func (p *fakeProfiledPlan) PageCacheMisses() int64 { return p.pageCacheMisses }
+func (p *fakeProfiledPlan) PageCacheHitRatio() float64 {
+	return float64(p.pageCacheHits) / float64(p.pageCacheHits+p.pageCacheMisses)
+}
+func (p *fakeProfiledPlan) Time() int64                    { return p.time }
+func (p *fakeProfiledPlan) Children() []neo4j.ProfiledPlan { return p.children }
+
+// fakeNotification is a notification reported without position
+type fakeNotification struct {
+	neo4j.Notification
+	code, title, description, severity, category string
+}
+
+func (n *fakeNotification) Code() string                  { return n.code }
+func (n *fakeNotification) Title() string                 { return n.title }
+func (n *fakeNotification) Description() string           { return n.description }
+func (n *fakeNotification) RawSeverityLevel() string      { return n.severity }
+func (n *fakeNotification) RawCategory() string           { return n.category }
+func (n *fakeNotification) Position() neo4j.InputPosition { return nil }
+
+// fakeCounters reports the updates held by a database.Counters
+type fakeCounters struct {
+	database.Counters
+}
+
+func (c fakeCounters) ContainsUpdates() bool       { return c.Counters != database.Counters{} }
+func (c fakeCounters) NodesCreated() int           { return c.Counters.NodesCreated }
+func (c fakeCounters) NodesDeleted() int           { return c.Counters.NodesDeleted }
+func (c fakeCounters) RelationshipsCreated() int   { return c.Counters.RelationshipsCreated }
+func (c fakeCounters) RelationshipsDeleted() int   { return c.Counters.RelationshipsDeleted }
+func (c fakeCounters) PropertiesSet() int          { return c.Counters.PropertiesSet }
+func (c fakeCounters) LabelsAdded() int            { return c.Counters.LabelsAdded }
+func (c fakeCounters) LabelsRemoved() int          { return c.Counters.LabelsRemoved }
+func (c fakeCounters) IndexesAdded() int           { return c.Counters.IndexesAdded }
+func (c fakeCounters) IndexesRemoved() int         { return c.Counters.IndexesRemoved }
+func (c fakeCounters) ConstraintsAdded() int       { return c.Counters.ConstraintsAdded }
+func (c fakeCounters) ConstraintsRemoved() int     { return c.Counters.ConstraintsRemoved }
+func (c fakeCounters) SystemUpdates() int          { return c.Counters.SystemUpdates }
+func (c fakeCounters) ContainsSystemUpdates() bool { return c.Counters.SystemUpdates > 0 }
@@ -13,22 +13,28 @@ type QueryExecutor interface {
 	// ExecuteReadQuery executes a read-only Cypher query and returns raw records
 	ExecuteReadQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, error)
 
+	// ExecuteReadQueryWithOptions executes a read-only Cypher query, consuming the result until the limits of opts are reached
+	ExecuteReadQueryWithOptions(ctx context.Context, cypher string, params map[string]any, opts ReadOptions) (*ReadResult, error)
+
 	// ExecuteWriteQuery executes a write-only Cypher query and returns raw records
 	ExecuteWriteQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, error)
 
+	// ExecuteWriteQueryWithCounters executes a write-only Cypher query and returns its records with the updates it made
+	ExecuteWriteQueryWithCounters(ctx context.Context, cypher string, params map[string]any) (*WriteResult, error)
+
 	// GetQueryType prefixes the provided query with EXPLAIN and returns the query type (e.g. 'r' for read, 'w' for write, 'rw' etc.)
 	// This allows read-only tools to determine if a query is safe to run in read-only context.
 	GetQueryType(ctx context.Context, cypher string, params map[string]any) (neo4j.StatementType, error)
-}
 
-// RecordFormatter defines the interface for formatting Neo4j records
-type RecordFormatter interface {
-	// Neo4jRecordsToJSON converts Neo4j records to JSON string
-	Neo4jRecordsToJSON(records []*neo4j.Record) (string, error)
+	// ExplainQuery prefixes the provided query with EXPLAIN and returns its plan and the notifications of the server, without running it
+	ExplainQuery(ctx context.Context, cypher string, params map[string]any) (*ExplainResult, error)
+
+	// ProfileQuery prefixes the provided query with PROFILE, runs it in a read transaction that is always rolled back
+	// and returns its profiled plan and the notifications of the server
+	ProfileQuery(ctx context.Context, cypher string, params map[string]any) (*ProfileResult, error)
 }
 
-// Service combines query execution and record formatting
+// Service runs the queries of the tools; their records are formatted by the format package
 type Service interface {
 	QueryExecutor
-	RecordFormatter
 }
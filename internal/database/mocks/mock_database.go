@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	database "github.com/neo4j/mcp/internal/database"
 	neo4j "github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -56,6 +57,21 @@ func (mr *MockServiceMockRecorder) ExecuteReadQuery(ctx, cypher, params any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteReadQuery", reflect.TypeOf((*MockService)(nil).ExecuteReadQuery), ctx, cypher, params)
 }
 
+// ExecuteReadQueryWithOptions mocks base method.
+func (m *MockService) ExecuteReadQueryWithOptions(ctx context.Context, cypher string, params map[string]any, opts database.ReadOptions) (*database.ReadResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteReadQueryWithOptions", ctx, cypher, params, opts)
+	ret0, _ := ret[0].(*database.ReadResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteReadQueryWithOptions indicates an expected call of ExecuteReadQueryWithOptions.
+func (mr *MockServiceMockRecorder) ExecuteReadQueryWithOptions(ctx, cypher, params, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteReadQueryWithOptions", reflect.TypeOf((*MockService)(nil).ExecuteReadQueryWithOptions), ctx, cypher, params, opts)
+}
+
 // ExecuteWriteQuery mocks base method.
 func (m *MockService) ExecuteWriteQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +87,36 @@ func (mr *MockServiceMockRecorder) ExecuteWriteQuery(ctx, cypher, params any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteWriteQuery", reflect.TypeOf((*MockService)(nil).ExecuteWriteQuery), ctx, cypher, params)
 }
 
+// ExecuteWriteQueryWithCounters mocks base method.
+func (m *MockService) ExecuteWriteQueryWithCounters(ctx context.Context, cypher string, params map[string]any) (*database.WriteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteWriteQueryWithCounters", ctx, cypher, params)
+	ret0, _ := ret[0].(*database.WriteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteWriteQueryWithCounters indicates an expected call of ExecuteWriteQueryWithCounters.
+func (mr *MockServiceMockRecorder) ExecuteWriteQueryWithCounters(ctx, cypher, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteWriteQueryWithCounters", reflect.TypeOf((*MockService)(nil).ExecuteWriteQueryWithCounters), ctx, cypher, params)
+}
+
+// ExplainQuery mocks base method.
+func (m *MockService) ExplainQuery(ctx context.Context, cypher string, params map[string]any) (*database.ExplainResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainQuery", ctx, cypher, params)
+	ret0, _ := ret[0].(*database.ExplainResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainQuery indicates an expected call of ExplainQuery.
+func (mr *MockServiceMockRecorder) ExplainQuery(ctx, cypher, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainQuery", reflect.TypeOf((*MockService)(nil).ExplainQuery), ctx, cypher, params)
+}
+
 // GetQueryType mocks base method.
 func (m *MockService) GetQueryType(ctx context.Context, cypher string, params map[string]any) (neo4j.StatementType, error) {
 	m.ctrl.T.Helper()
@@ -86,17 +132,17 @@ func (mr *MockServiceMockRecorder) GetQueryType(ctx, cypher, params any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryType", reflect.TypeOf((*MockService)(nil).GetQueryType), ctx, cypher, params)
 }
 
-// Neo4jRecordsToJSON mocks base method.
-func (m *MockService) Neo4jRecordsToJSON(records []*neo4j.Record) (string, error) {
+// ProfileQuery mocks base method.
+func (m *MockService) ProfileQuery(ctx context.Context, cypher string, params map[string]any) (*database.ProfileResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Neo4jRecordsToJSON", records)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ProfileQuery", ctx, cypher, params)
+	ret0, _ := ret[0].(*database.ProfileResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Neo4jRecordsToJSON indicates an expected call of Neo4jRecordsToJSON.
-func (mr *MockServiceMockRecorder) Neo4jRecordsToJSON(records any) *gomock.Call {
+// ProfileQuery indicates an expected call of ProfileQuery.
+func (mr *MockServiceMockRecorder) ProfileQuery(ctx, cypher, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Neo4jRecordsToJSON", reflect.TypeOf((*MockService)(nil).Neo4jRecordsToJSON), records)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProfileQuery", reflect.TypeOf((*MockService)(nil).ProfileQuery), ctx, cypher, params)
 }
@@ -0,0 +1,193 @@
+package database
+
+import (
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// estimatedRowsArgument is the plan argument holding the number of rows the planner expects an operator to produce
+const estimatedRowsArgument = "EstimatedRows"
+
+// profileArguments are the arguments of profiled plans already reported by the fields of ProfiledPlan
+var profileArguments = map[string]bool{
+	estimatedRowsArgument: true,
+	"Rows":                true,
+	"DbHits":              true,
+	"PageCacheHits":       true,
+	"PageCacheMisses":     true,
+	"PageCacheHitRatio":   true,
+	"Time":                true,
+}
+
+// ExplainResult holds the plan of a query explained with ExplainQuery
+type ExplainResult struct {
+	// StatementType is READ_ONLY, READ_WRITE, WRITE_ONLY or SCHEMA_WRITE
+	StatementType string         `json:"statementType"`
+	Plan          *Plan          `json:"plan,omitempty"`
+	Notifications []Notification `json:"notifications"`
+}
+
+// Plan is an operator of a query plan, taking its input from its children
+type Plan struct {
+	Operator      string         `json:"operator"`
+	EstimatedRows float64        `json:"estimatedRows"`
+	Identifiers   []string       `json:"identifiers"`
+	Arguments     map[string]any `json:"arguments,omitempty"`
+	Children      []*Plan        `json:"children,omitempty"`
+}
+
+// ProfileResult holds the profiled plan of a query executed with ProfileQuery
+type ProfileResult struct {
+	// StatementType is READ_ONLY, READ_WRITE, WRITE_ONLY or SCHEMA_WRITE
+	StatementType string         `json:"statementType"`
+	Rows          int            `json:"rows"`   // number of records the query returned
+	DbHits        int64          `json:"dbHits"` // db hits of every operator
+	Plan          *ProfiledPlan  `json:"plan,omitempty"`
+	Notifications []Notification `json:"notifications"`
+}
+
+// ProfiledPlan is an operator of an executed query plan, with the work it did, taking its input from its children
+type ProfiledPlan struct {
+	Operator          string          `json:"operator"`
+	EstimatedRows     float64         `json:"estimatedRows"`
+	Rows              int64           `json:"rows"`
+	DbHits            int64           `json:"dbHits"`
+	PageCacheHits     int64           `json:"pageCacheHits"`
+	PageCacheMisses   int64           `json:"pageCacheMisses"`
+	PageCacheHitRatio float64         `json:"pageCacheHitRatio"`
+	TimeNs            int64           `json:"timeNs"` // time spent in the operator, in nanoseconds, 0 when the runtime does not report it
+	Identifiers       []string        `json:"identifiers"`
+	Arguments         map[string]any  `json:"arguments,omitempty"`
+	Children          []*ProfiledPlan `json:"children,omitempty"`
+}
+
+// TotalDbHits returns the db hits of the operator and its children
+func (p *ProfiledPlan) TotalDbHits() int64 {
+	if p == nil {
+		return 0
+	}
+	total := p.DbHits
+	for _, child := range p.Children {
+		total += child.TotalDbHits()
+	}
+	return total
+}
+
+// Notification is a warning or information the server reported about a query, such as a deprecation or a performance issue
+type Notification struct {
+	Code        string    `json:"code"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Severity    string    `json:"severity"`
+	Category    string    `json:"category,omitempty"`
+	Position    *Position `json:"position,omitempty"`
+}
+
+// Position locates a notification in the query
+type Position struct {
+	Offset int `json:"offset"`
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// statementTypeNames are the names of the statement types, as used by Neo4j
+var statementTypeNames = map[neo4j.StatementType]string{
+	neo4j.StatementTypeReadOnly:    "READ_ONLY",
+	neo4j.StatementTypeReadWrite:   "READ_WRITE",
+	neo4j.StatementTypeWriteOnly:   "WRITE_ONLY",
+	neo4j.StatementTypeSchemaWrite: "SCHEMA_WRITE",
+}
+
+// statementTypeName returns the name of a statement type, UNKNOWN when it is not known
+func statementTypeName(t neo4j.StatementType) string {
+	if name, ok := statementTypeNames[t]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// planFromSummary copies the plan of a driver plan tree, nil when there is none
+func planFromSummary(p neo4j.Plan) *Plan {
+	if p == nil {
+		return nil
+	}
+	plan := &Plan{
+		Operator:    p.Operator(),
+		Identifiers: p.Identifiers(),
+		Arguments:   make(map[string]any, len(p.Arguments())),
+	}
+	for name, value := range p.Arguments() {
+		if name == estimatedRowsArgument {
+			plan.EstimatedRows = toFloat(value)
+			continue
+		}
+		plan.Arguments[name] = value
+	}
+	for _, child := range p.Children() {
+		plan.Children = append(plan.Children, planFromSummary(child))
+	}
+	return plan
+}
+
+// profiledPlanFromSummary copies the plan of a driver profiled plan tree, nil when there is none
+func profiledPlanFromSummary(p neo4j.ProfiledPlan) *ProfiledPlan {
+	if p == nil {
+		return nil
+	}
+	plan := &ProfiledPlan{
+		Operator:          p.Operator(),
+		EstimatedRows:     toFloat(p.Arguments()[estimatedRowsArgument]),
+		Rows:              p.Records(),
+		DbHits:            p.DbHits(),
+		PageCacheHits:     p.PageCacheHits(),
+		PageCacheMisses:   p.PageCacheMisses(),
+		PageCacheHitRatio: p.PageCacheHitRatio(),
+		TimeNs:            p.Time(),
+		Identifiers:       p.Identifiers(),
+		Arguments:         make(map[string]any, len(p.Arguments())),
+	}
+	for name, value := range p.Arguments() {
+		if !profileArguments[name] {
+			plan.Arguments[name] = value
+		}
+	}
+	for _, child := range p.Children() {
+		plan.Children = append(plan.Children, profiledPlanFromSummary(child))
+	}
+	return plan
+}
+
+// notificationsFromSummary copies the notifications of a driver result summary
+func notificationsFromSummary(summary neo4j.ResultSummary) []Notification {
+	notifications := make([]Notification, 0)
+	if summary == nil {
+		return notifications
+	}
+	for _, n := range summary.Notifications() {
+		notification := Notification{
+			Code:        n.Code(),
+			Title:       n.Title(),
+			Description: n.Description(),
+			Severity:    n.RawSeverityLevel(),
+			Category:    n.RawCategory(),
+		}
+		if position := n.Position(); position != nil {
+			notification.Position = &Position{Offset: position.Offset(), Line: position.Line(), Column: position.Column()}
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications
+}
+
+// toFloat converts a numeric plan argument to a float64, 0 when it is not numeric
+func toFloat(value any) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	case int:
+		return float64(v)
+	default:
+		return 0
+	}
+}
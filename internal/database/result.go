@@ -0,0 +1,109 @@
+package database
+
+import (
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// ReadOptions limits the records returned by ExecuteReadQueryWithOptions.
+// A zero limit disables the corresponding check.
+type ReadOptions struct {
+	MaxRows  int // maximum number of records returned
+	MaxBytes int // approximate maximum size of the returned records once formatted, checked only with RecordSize
+	// RecordSize estimates the size of a record in the format it is returned in, e.g. with format.RecordFormatter.RecordSize
+	RecordSize func(record *neo4j.Record) int
+	// Skip is the number of leading records left out, to return the next page of a result
+	Skip int
+	// Bookmarks make the query read data at least as recent as the transactions they were returned by
+	Bookmarks []string
+}
+
+// ReadResult holds the records of a read query executed with ExecuteReadQueryWithOptions
+type ReadResult struct {
+	Keys    []string
+	Records []*neo4j.Record
+	// Truncated reports whether records were left out because a limit was reached
+	Truncated bool
+	// OmittedRows is the number of records left out of Records after them, Skip excluded
+	OmittedRows int
+	// MoreRows reports whether records follow the OmittedRows ones, left unread once too many were counted
+	MoreRows bool
+	// Bookmarks of the transaction that read the records, to read the next page at least as recent data
+	Bookmarks []string
+	Summary
+}
+
+// WriteResult holds the records and the update counters of a write query executed with ExecuteWriteQueryWithCounters
+type WriteResult struct {
+	Keys     []string
+	Records  []*neo4j.Record
+	Counters Counters
+	Summary
+}
+
+// Summary holds what the server reported about a query besides its records and updates
+type Summary struct {
+	Notifications []Notification
+	// ResultAvailableAfter is the time it took the server to make the result available, negative when it was not reported
+	ResultAvailableAfter time.Duration
+	// ResultConsumedAfter is the time it took the server to consume the result, negative when it was not reported
+	ResultConsumedAfter time.Duration
+}
+
+// Counters holds the updates reported by the summary of a write query
+type Counters struct {
+	NodesCreated         int `json:"nodesCreated,omitempty"`
+	NodesDeleted         int `json:"nodesDeleted,omitempty"`
+	RelationshipsCreated int `json:"relationshipsCreated,omitempty"`
+	RelationshipsDeleted int `json:"relationshipsDeleted,omitempty"`
+	PropertiesSet        int `json:"propertiesSet,omitempty"`
+	LabelsAdded          int `json:"labelsAdded,omitempty"`
+	LabelsRemoved        int `json:"labelsRemoved,omitempty"`
+	IndexesAdded         int `json:"indexesAdded,omitempty"`
+	IndexesRemoved       int `json:"indexesRemoved,omitempty"`
+	ConstraintsAdded     int `json:"constraintsAdded,omitempty"`
+	ConstraintsRemoved   int `json:"constraintsRemoved,omitempty"`
+	SystemUpdates        int `json:"systemUpdates,omitempty"`
+}
+
+// SchemaChanged reports whether the query added or removed labels, indexes or constraints
+func (c Counters) SchemaChanged() bool {
+	return c.LabelsAdded > 0 || c.LabelsRemoved > 0 ||
+		c.IndexesAdded > 0 || c.IndexesRemoved > 0 ||
+		c.ConstraintsAdded > 0 || c.ConstraintsRemoved > 0
+}
+
+// countersFromSummary copies the counters of a driver result summary
+func countersFromSummary(summary neo4j.ResultSummary) Counters {
+	if summary == nil || summary.Counters() == nil {
+		return Counters{}
+	}
+	c := summary.Counters()
+	return Counters{
+		NodesCreated:         c.NodesCreated(),
+		NodesDeleted:         c.NodesDeleted(),
+		RelationshipsCreated: c.RelationshipsCreated(),
+		RelationshipsDeleted: c.RelationshipsDeleted(),
+		PropertiesSet:        c.PropertiesSet(),
+		LabelsAdded:          c.LabelsAdded(),
+		LabelsRemoved:        c.LabelsRemoved(),
+		IndexesAdded:         c.IndexesAdded(),
+		IndexesRemoved:       c.IndexesRemoved(),
+		ConstraintsAdded:     c.ConstraintsAdded(),
+		ConstraintsRemoved:   c.ConstraintsRemoved(),
+		SystemUpdates:        c.SystemUpdates(),
+	}
+}
+
+// summaryFromResult copies the notifications and timings of a driver result summary
+func summaryFromResult(summary neo4j.ResultSummary) Summary {
+	if summary == nil {
+		return Summary{ResultAvailableAfter: -1, ResultConsumedAfter: -1}
+	}
+	return Summary{
+		Notifications:        notificationsFromSummary(summary),
+		ResultAvailableAfter: summary.ResultAvailableAfter(),
+		ResultConsumedAfter:  summary.ResultConsumedAfter(),
+	}
+}
@@ -0,0 +1,197 @@
+package database
+
+import (
+	"encoding/base64"
+	"math"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Spatial reference IDs of the coordinate reference systems supported by Neo4j
+const (
+	sridWGS84       = 4326
+	sridWGS84_3D    = 4979
+	sridCartesian   = 7203
+	sridCartesian3D = 9157
+)
+
+// Layouts of the temporal values, ISO-8601 as printed by Cypher
+const (
+	dateLayout          = "2006-01-02"
+	localTimeLayout     = "15:04:05.999999999"
+	timeLayout          = "15:04:05.999999999Z07:00"
+	localDateTimeLayout = "2006-01-02T15:04:05.999999999"
+	dateTimeLayout      = "2006-01-02T15:04:05.999999999Z07:00"
+)
+
+// offsetZoneName is the name the driver gives to the fixed zones of temporal values carrying an offset but no zone ID
+const offsetZoneName = "Offset"
+
+// SerializeRecord returns the values of record keyed by column, each converted by SerializeValue
+func SerializeRecord(record *neo4j.Record) map[string]any {
+	serialized := make(map[string]any, len(record.Keys))
+	for i, key := range record.Keys {
+		if i < len(record.Values) {
+			serialized[key] = SerializeValue(record.Values[i])
+		}
+	}
+	return serialized
+}
+
+// SerializeValue converts a value returned by the driver to a value marshaling to a stable JSON shape:
+//
+//   - nodes: {"elementId", "labels", "properties"}
+//   - relationships: {"elementId", "type", "startElementId", "endElementId", "properties"}
+//   - paths: {"start", "end", "length", "segments": [{"start", "relationship", "end"}]}, nodes and relationships as above
+//   - dates, times, local times, local date times and date times: ISO-8601 strings as printed by Cypher,
+//     date times with a zone ID followed by the ID in brackets, e.g. 2024-05-01T10:00:00+02:00[Europe/Paris]
+//   - durations: ISO-8601 strings with nine fractional digits of seconds, e.g. P1M2DT3.500000000S
+//   - points: {"crs", "srid", "x", "y"} with "z" for 3D points; WGS-84 points use "longitude", "latitude" and "height"
+//   - byte arrays: {"type": "bytes", "size", "base64"}
+//   - vectors left out by ElideVectors: {"type": "vector", "dims"}
+//   - NaN and infinite floats: the strings "NaN", "Infinity" and "-Infinity"
+//
+// Lists and maps are converted element by element; other values are returned unchanged.
+func SerializeValue(value any) any {
+	switch v := value.(type) {
+	case neo4j.Node:
+		return serializeNode(v)
+	case neo4j.Relationship:
+		return serializeRelationship(v)
+	case neo4j.Path:
+		return serializePath(v)
+	case neo4j.Date:
+		return v.Time().Format(dateLayout)
+	case neo4j.LocalTime:
+		return v.Time().Format(localTimeLayout)
+	case neo4j.Time:
+		return v.Time().Format(timeLayout)
+	case neo4j.LocalDateTime:
+		return v.Time().Format(localDateTimeLayout)
+	case time.Time:
+		return serializeDateTime(v)
+	case neo4j.Duration:
+		return v.String()
+	case neo4j.Point2D:
+		return serializePoint(v.SpatialRefId, v.X, v.Y, nil)
+	case neo4j.Point3D:
+		return serializePoint(v.SpatialRefId, v.X, v.Y, &v.Z)
+	case []byte:
+		return map[string]any{"type": "bytes", "size": len(v), "base64": base64.StdEncoding.EncodeToString(v)}
+	case Vector:
+		return map[string]any{"type": "vector", "dims": v.Dims}
+	case float64:
+		return serializeFloat(v)
+	case []any:
+		serialized := make([]any, len(v))
+		for i, element := range v {
+			serialized[i] = SerializeValue(element)
+		}
+		return serialized
+	case map[string]any:
+		return serializeProperties(v)
+	default:
+		return value
+	}
+}
+
+func serializeNode(node neo4j.Node) map[string]any {
+	labels := node.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	return map[string]any{
+		"elementId":  node.ElementId,
+		"labels":     labels,
+		"properties": serializeProperties(node.Props),
+	}
+}
+
+func serializeRelationship(relationship neo4j.Relationship) map[string]any {
+	return map[string]any{
+		"elementId":      relationship.ElementId,
+		"type":           relationship.Type,
+		"startElementId": relationship.StartElementId,
+		"endElementId":   relationship.EndElementId,
+		"properties":     serializeProperties(relationship.Props),
+	}
+}
+
+// serializePath returns a path as the segments joining its nodes, each relationship keeping its own direction
+func serializePath(path neo4j.Path) map[string]any {
+	segments := make([]any, 0, len(path.Relationships))
+	for i, relationship := range path.Relationships {
+		if i+1 >= len(path.Nodes) {
+			break
+		}
+		segments = append(segments, map[string]any{
+			"start":        serializeNode(path.Nodes[i]),
+			"relationship": serializeRelationship(relationship),
+			"end":          serializeNode(path.Nodes[i+1]),
+		})
+	}
+
+	serialized := map[string]any{"length": len(segments), "segments": segments}
+	if len(path.Nodes) > 0 {
+		serialized["start"] = serializeNode(path.Nodes[0])
+		serialized["end"] = serializeNode(path.Nodes[len(segments)])
+	}
+	return serialized
+}
+
+func serializeProperties(properties map[string]any) map[string]any {
+	serialized := make(map[string]any, len(properties))
+	for key, value := range properties {
+		serialized[key] = SerializeValue(value)
+	}
+	return serialized
+}
+
+// serializeDateTime formats a date time with its offset, followed by its zone ID when it has one
+func serializeDateTime(t time.Time) string {
+	formatted := t.Format(dateTimeLayout)
+	if zone := t.Location().String(); zone != offsetZoneName && zone != "Local" && zone != "" {
+		formatted += "[" + zone + "]"
+	}
+	return formatted
+}
+
+// serializePoint returns a point with the names of its coordinate reference system, z being nil for 2D points
+func serializePoint(srid uint32, x, y float64, z *float64) map[string]any {
+	switch srid {
+	case sridWGS84, sridWGS84_3D:
+		point := map[string]any{"crs": "wgs-84", "srid": srid, "longitude": x, "latitude": y}
+		if z != nil {
+			point["crs"] = "wgs-84-3d"
+			point["height"] = *z
+		}
+		return point
+	default:
+		point := map[string]any{"crs": "cartesian", "srid": srid, "x": x, "y": y}
+		if srid != sridCartesian && srid != sridCartesian3D {
+			point["crs"] = "unknown"
+		}
+		if z != nil {
+			if srid == sridCartesian3D {
+				point["crs"] = "cartesian-3d"
+			}
+			point["z"] = *z
+		}
+		return point
+	}
+}
+
+// serializeFloat returns the floats JSON cannot represent as strings
+func serializeFloat(f float64) any {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	default:
+		return f
+	}
+}
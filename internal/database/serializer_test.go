@@ -0,0 +1,312 @@
+package database_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// roundTrip serializes value to JSON and parses it back
+func roundTrip(t *testing.T, value any) any {
+	t.Helper()
+	formatted, err := json.Marshal(database.SerializeValue(value))
+	if err != nil {
+		t.Fatalf("failed to marshal %T: %v", value, err)
+	}
+	var parsed any
+	if err := json.Unmarshal(formatted, &parsed); err != nil {
+		t.Fatalf("failed to unmarshal %s: %v", formatted, err)
+	}
+	return parsed
+}
+
+// parseNode reads back a serialized node
+func parseNode(t *testing.T, value any) neo4j.Node {
+	t.Helper()
+	m, ok := value.(map[string]any)
+	if !ok {
+		t.Fatalf("expected a node object, got %T", value)
+	}
+	node := neo4j.Node{ElementId: m["elementId"].(string), Labels: []string{}, Props: m["properties"].(map[string]any)}
+	for _, label := range m["labels"].([]any) {
+		node.Labels = append(node.Labels, label.(string))
+	}
+	return node
+}
+
+// parseRelationship reads back a serialized relationship
+func parseRelationship(t *testing.T, value any) neo4j.Relationship {
+	t.Helper()
+	m, ok := value.(map[string]any)
+	if !ok {
+		t.Fatalf("expected a relationship object, got %T", value)
+	}
+	return neo4j.Relationship{
+		ElementId:      m["elementId"].(string),
+		Type:           m["type"].(string),
+		StartElementId: m["startElementId"].(string),
+		EndElementId:   m["endElementId"].(string),
+		Props:          m["properties"].(map[string]any),
+	}
+}
+
+// parseTime reads back a serialized temporal value with the given layout
+func parseTime(t *testing.T, value any, layout string) time.Time {
+	t.Helper()
+	s, ok := value.(string)
+	if !ok {
+		t.Fatalf("expected a string, got %T", value)
+	}
+	parsed, err := time.Parse(layout, s)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", s, err)
+	}
+	return parsed
+}
+
+func TestSerializeValue(t *testing.T) {
+	alice := neo4j.Node{ElementId: "4:db:1", Labels: []string{"Person"}, Props: map[string]any{"name": "Alice", "age": float64(30)}}
+	bob := neo4j.Node{ElementId: "4:db:2", Labels: []string{"Person"}, Props: map[string]any{"name": "Bob"}}
+	knows := neo4j.Relationship{ElementId: "5:db:1", Type: "KNOWS", StartElementId: "4:db:1", EndElementId: "4:db:2", Props: map[string]any{"since": float64(2020)}}
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("failed to load time zone: %v", err)
+	}
+
+	t.Run("node", func(t *testing.T) {
+		if got := parseNode(t, roundTrip(t, alice)); !reflect.DeepEqual(got, alice) {
+			t.Errorf("expected %+v, got %+v", alice, got)
+		}
+	})
+
+	t.Run("node without labels", func(t *testing.T) {
+		got := roundTrip(t, neo4j.Node{ElementId: "4:db:3"}).(map[string]any)
+		if labels, ok := got["labels"].([]any); !ok || len(labels) != 0 {
+			t.Errorf("expected an empty list of labels, got %v", got["labels"])
+		}
+		if properties, ok := got["properties"].(map[string]any); !ok || len(properties) != 0 {
+			t.Errorf("expected an empty map of properties, got %v", got["properties"])
+		}
+	})
+
+	t.Run("relationship", func(t *testing.T) {
+		if got := parseRelationship(t, roundTrip(t, knows)); !reflect.DeepEqual(got, knows) {
+			t.Errorf("expected %+v, got %+v", knows, got)
+		}
+	})
+
+	t.Run("path", func(t *testing.T) {
+		path := neo4j.Path{Nodes: []neo4j.Node{alice, bob}, Relationships: []neo4j.Relationship{knows}}
+		got := roundTrip(t, path).(map[string]any)
+
+		if got["length"] != float64(1) {
+			t.Errorf("expected length 1, got %v", got["length"])
+		}
+		parsed := neo4j.Path{Nodes: []neo4j.Node{parseNode(t, got["start"])}}
+		for _, segment := range got["segments"].([]any) {
+			s := segment.(map[string]any)
+			parsed.Relationships = append(parsed.Relationships, parseRelationship(t, s["relationship"]))
+			parsed.Nodes = append(parsed.Nodes, parseNode(t, s["end"]))
+		}
+		if !reflect.DeepEqual(parsed, path) {
+			t.Errorf("expected %+v, got %+v", path, parsed)
+		}
+		if end := parseNode(t, got["end"]); !reflect.DeepEqual(end, bob) {
+			t.Errorf("expected end %+v, got %+v", bob, end)
+		}
+	})
+
+	t.Run("path of a single node", func(t *testing.T) {
+		got := roundTrip(t, neo4j.Path{Nodes: []neo4j.Node{alice}}).(map[string]any)
+		if got["length"] != float64(0) || len(got["segments"].([]any)) != 0 {
+			t.Errorf("expected an empty path, got %v", got)
+		}
+		if start, end := parseNode(t, got["start"]), parseNode(t, got["end"]); !reflect.DeepEqual(start, alice) || !reflect.DeepEqual(end, alice) {
+			t.Errorf("expected the path to start and end with %+v, got %+v and %+v", alice, start, end)
+		}
+	})
+
+	temporals := []struct {
+		name   string
+		value  any
+		want   string
+		layout string
+		time   time.Time
+	}{
+		{
+			name:   "date",
+			value:  neo4j.Date(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+			want:   "2024-05-01",
+			layout: "2006-01-02",
+			time:   time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "local time",
+			value:  neo4j.LocalTime(time.Date(0, 1, 1, 10, 30, 15, 500000000, time.UTC)),
+			want:   "10:30:15.5",
+			layout: "15:04:05.999999999",
+			time:   time.Date(0, 1, 1, 10, 30, 15, 500000000, time.UTC),
+		},
+		{
+			name:   "time",
+			value:  neo4j.Time(time.Date(0, 1, 1, 10, 30, 0, 0, time.FixedZone("Offset", 2*3600))),
+			want:   "10:30:00+02:00",
+			layout: "15:04:05.999999999Z07:00",
+			time:   time.Date(0, 1, 1, 10, 30, 0, 0, time.FixedZone("", 2*3600)),
+		},
+		{
+			name:   "local date time",
+			value:  neo4j.LocalDateTime(time.Date(2024, 5, 1, 10, 30, 0, 1, time.UTC)),
+			want:   "2024-05-01T10:30:00.000000001",
+			layout: "2006-01-02T15:04:05.999999999",
+			time:   time.Date(2024, 5, 1, 10, 30, 0, 1, time.UTC),
+		},
+		{
+			name:   "date time with offset",
+			value:  time.Date(2024, 5, 1, 10, 30, 0, 0, time.FixedZone("Offset", -5*3600)),
+			want:   "2024-05-01T10:30:00-05:00",
+			layout: time.RFC3339Nano,
+			time:   time.Date(2024, 5, 1, 15, 30, 0, 0, time.UTC),
+		},
+		{
+			name:   "date time with zone ID",
+			value:  time.Date(2024, 5, 1, 10, 30, 0, 0, paris),
+			want:   "2024-05-01T10:30:00+02:00[Europe/Paris]",
+			layout: time.RFC3339Nano,
+			time:   time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range temporals {
+		t.Run(tt.name, func(t *testing.T) {
+			got := roundTrip(t, tt.value)
+			if got != tt.want {
+				t.Fatalf("expected %q, got %v", tt.want, got)
+			}
+			// The zone ID is not part of ISO-8601, the offset before it is enough to read the instant back
+			s, _, _ := strings.Cut(got.(string), "[")
+			if parsed := parseTime(t, s, tt.layout); !parsed.Equal(tt.time) {
+				t.Errorf("expected %v, got %v", tt.time, parsed)
+			}
+		})
+	}
+
+	t.Run("duration", func(t *testing.T) {
+		duration := neo4j.Duration{Months: 14, Days: 3, Seconds: 3661, Nanos: 500000000}
+		if got := roundTrip(t, duration); got != "P14M3DT3661.500000000S" {
+			t.Errorf("expected P14M3DT3661.500000000S, got %v", got)
+		}
+	})
+
+	points := []struct {
+		name  string
+		value any
+		want  map[string]any
+	}{
+		{
+			name:  "WGS-84 point",
+			value: neo4j.Point2D{X: 2.35, Y: 48.85, SpatialRefId: 4326},
+			want:  map[string]any{"crs": "wgs-84", "srid": float64(4326), "longitude": 2.35, "latitude": 48.85},
+		},
+		{
+			name:  "WGS-84 3D point",
+			value: neo4j.Point3D{X: 2.35, Y: 48.85, Z: 35, SpatialRefId: 4979},
+			want:  map[string]any{"crs": "wgs-84-3d", "srid": float64(4979), "longitude": 2.35, "latitude": 48.85, "height": float64(35)},
+		},
+		{
+			name:  "cartesian point",
+			value: neo4j.Point2D{X: 1, Y: 2, SpatialRefId: 7203},
+			want:  map[string]any{"crs": "cartesian", "srid": float64(7203), "x": float64(1), "y": float64(2)},
+		},
+		{
+			name:  "cartesian 3D point",
+			value: neo4j.Point3D{X: 1, Y: 2, Z: 3, SpatialRefId: 9157},
+			want:  map[string]any{"crs": "cartesian-3d", "srid": float64(9157), "x": float64(1), "y": float64(2), "z": float64(3)},
+		},
+	}
+	for _, tt := range points {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roundTrip(t, tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("byte array", func(t *testing.T) {
+		bytes := []byte{0, 1, 2, 255}
+		got := roundTrip(t, bytes).(map[string]any)
+		if got["type"] != "bytes" || got["size"] != float64(4) {
+			t.Errorf("expected 4 bytes, got %v", got)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(got["base64"].(string))
+		if err != nil || !reflect.DeepEqual(decoded, bytes) {
+			t.Errorf("expected %v, got %v (%v)", bytes, decoded, err)
+		}
+	})
+
+	t.Run("special floats", func(t *testing.T) {
+		got := roundTrip(t, []any{math.NaN(), math.Inf(1), math.Inf(-1), 1.5})
+		want := []any{"NaN", "Infinity", "-Infinity", 1.5}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("nested lists and maps", func(t *testing.T) {
+		value := map[string]any{
+			"friends": []any{alice, bob},
+			"meta":    map[string]any{"born": neo4j.Date(time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC)), "score": int64(3)},
+		}
+		got := roundTrip(t, value).(map[string]any)
+
+		friends := got["friends"].([]any)
+		if len(friends) != 2 || !reflect.DeepEqual(parseNode(t, friends[0]), alice) || !reflect.DeepEqual(parseNode(t, friends[1]), bob) {
+			t.Errorf("expected the friends to be nodes, got %v", friends)
+		}
+		want := map[string]any{"born": "1990-01-02", "score": float64(3)}
+		if !reflect.DeepEqual(got["meta"], want) {
+			t.Errorf("expected %v, got %v", want, got["meta"])
+		}
+	})
+
+	t.Run("other values are unchanged", func(t *testing.T) {
+		for _, value := range []any{nil, "text", int64(42), true} {
+			if got := database.SerializeValue(value); got != value {
+				t.Errorf("expected %v, got %v", value, got)
+			}
+		}
+	})
+}
+
+func TestSerializeRecord(t *testing.T) {
+	record := &neo4j.Record{
+		Keys:   []string{"n", "born"},
+		Values: []any{neo4j.Node{ElementId: "4:db:1", Labels: []string{"Person"}, Props: map[string]any{}}, neo4j.Date(time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC))},
+	}
+	formatted, err := json.MarshalIndent([]map[string]any{database.SerializeRecord(record)}, "", "  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := string(formatted)
+	want := `[
+  {
+    "born": "1990-01-02",
+    "n": {
+      "elementId": "4:db:1",
+      "labels": [
+        "Person"
+      ],
+      "properties": {}
+    }
+  }
+]`
+	if got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+}
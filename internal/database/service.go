@@ -2,14 +2,21 @@ package database
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
+// cleanupTimeout bounds how long rolling back a transaction and closing its session may take, once the query context is cancelled
+const cleanupTimeout = 5 * time.Second
+
+// maxCountedRows bounds how many records are read past the limits of a read to count the omitted ones,
+// so that a huge result does not keep the transaction busy once nothing more is kept
+const maxCountedRows = 1000
+
 // Neo4jService is the concrete implementation of DatabaseService
 type Neo4jService struct {
 	driver   neo4j.DriverWithContext
@@ -28,29 +35,207 @@ func NewNeo4jService(driver neo4j.DriverWithContext, database string) (*Neo4jSer
 	}, nil
 }
 
-// ExecuteReadQuery executes a read-only Cypher query and returns raw records
-func (s *Neo4jService) ExecuteReadQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, error) {
+// txConfigurers returns the transaction configuration carried by ctx (see WithTxTimeout and WithTxMetadata)
+func txConfigurers(ctx context.Context) []func(*neo4j.TransactionConfig) {
+	var configurers []func(*neo4j.TransactionConfig)
+	if timeout, ok := TxTimeoutFromContext(ctx); ok {
+		configurers = append(configurers, neo4j.WithTxTimeout(timeout))
+	}
+	if metadata := TxMetadataFromContext(ctx); len(metadata) > 0 {
+		configurers = append(configurers, neo4j.WithTxMetadata(metadata))
+	}
+	return configurers
+}
 
-	res, err := neo4j.ExecuteQuery(ctx, s.driver, cypher, params, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase(s.database), neo4j.ExecuteQueryWithReadersRouting())
+// ExecuteReadQuery executes a read-only Cypher query and returns raw records, streamed from the server into a single slice
+func (s *Neo4jService) ExecuteReadQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, error) {
+	var records []*neo4j.Record
+	_, err := s.runInTransaction(ctx, neo4j.AccessModeRead, nil, func(tx neo4j.ManagedTransaction) error {
+		res, err := tx.Run(ctx, cypher, params)
+		if err != nil {
+			return err
+		}
+		records, err = res.Collect(ctx)
+		return err
+	})
 	if err != nil {
 		wrappedErr := fmt.Errorf("failed to execute read query: %w", err)
 		log.Printf("Error in ExecuteReadQuery: %v", wrappedErr)
 		return nil, wrappedErr
 	}
 
-	return res.Records, nil
+	return records, nil
+}
+
+// sessionConfig returns the configuration of a session with the given access mode, honouring the database and credentials carried by ctx.
+// Sessions share the bookmark manager of the driver, so that every query reads the writes of the queries completed before it.
+func (s *Neo4jService) sessionConfig(ctx context.Context, mode neo4j.AccessMode) neo4j.SessionConfig {
+	config := neo4j.SessionConfig{AccessMode: mode, DatabaseName: s.database, BookmarkManager: s.driver.ExecuteQueryBookmarkManager()}
+	if name, ok := DatabaseFromContext(ctx); ok {
+		config.DatabaseName = name
+	}
+	if token, ok := AuthTokenFromContext(ctx); ok {
+		config.Auth = &token
+	}
+	return config
+}
+
+// ExecuteReadQueryWithOptions executes a read-only Cypher query, consuming the result until the limits of opts are reached.
+// Records past the limits are counted but not kept, so large results do not exhaust memory.
+func (s *Neo4jService) ExecuteReadQueryWithOptions(ctx context.Context, cypher string, params map[string]any, opts ReadOptions) (*ReadResult, error) {
+	var result *ReadResult
+	bookmarks, err := s.runInTransaction(ctx, neo4j.AccessModeRead, opts.Bookmarks, func(tx neo4j.ManagedTransaction) error {
+		var err error
+		result, err = readWithLimits(ctx, tx, cypher, params, opts)
+		return err
+	})
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to execute read query: %w", err)
+		log.Printf("Error in ExecuteReadQueryWithOptions: %v", wrappedErr)
+		return nil, wrappedErr
+	}
+
+	result.Bookmarks = bookmarks
+	return result, nil
+}
+
+// runInTransaction runs work in a managed transaction of a new session with the given access mode, so that the driver
+// retries it on transient errors and leader switches, and commits it when work succeeds.
+// When work fails, including when ctx is cancelled, the driver does not commit: it resets or closes the connection,
+// and the server then rolls the transaction back and terminates the running query.
+// work may run several times and must not keep state across attempts. It returns the bookmarks of the committed transaction.
+func (s *Neo4jService) runInTransaction(ctx context.Context, mode neo4j.AccessMode, bookmarks []string, work func(tx neo4j.ManagedTransaction) error) ([]string, error) {
+	config := s.sessionConfig(ctx, mode)
+	if len(bookmarks) > 0 {
+		config.Bookmarks = neo4j.BookmarksFromRawValues(bookmarks...)
+	}
+	session := s.driver.NewSession(ctx, config)
+	// Closing the session must not be skipped when ctx is cancelled
+	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cleanupTimeout)
+	defer cancel()
+	defer session.Close(cleanupCtx)
+
+	execute := session.ExecuteRead
+	if mode == neo4j.AccessModeWrite {
+		execute = session.ExecuteWrite
+	}
+	if _, err := execute(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return nil, work(tx)
+	}, txConfigurers(ctx)...); err != nil {
+		return nil, err
+	}
+
+	return neo4j.BookmarksToRawValues(session.LastBookmarks()), nil
+}
+
+// runRolledBack runs work in a transaction of a new session with the given access mode and always rolls it back,
+// so that work cannot change the database, even when it succeeds
+func (s *Neo4jService) runRolledBack(ctx context.Context, mode neo4j.AccessMode, work func(tx neo4j.ExplicitTransaction) error) error {
+	session := s.driver.NewSession(ctx, s.sessionConfig(ctx, mode))
+	// Cleaning up must not be skipped when ctx is cancelled
+	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cleanupTimeout)
+	defer cancel()
+	defer session.Close(cleanupCtx)
+
+	tx, err := session.BeginTransaction(ctx, txConfigurers(ctx)...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(cleanupCtx); rollbackErr != nil {
+			log.Printf("Error rolling back transaction: %v", rollbackErr)
+		}
+	}()
+	return work(tx)
+}
+
+// readWithLimits runs cypher in tx, skips opts.Skip records and collects the next ones until a limit of opts is reached,
+// then counts the remaining ones, up to maxCountedRows
+func readWithLimits(ctx context.Context, tx neo4j.ManagedTransaction, cypher string, params map[string]any, opts ReadOptions) (*ReadResult, error) {
+	res, err := tx.Run(ctx, cypher, params)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := res.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReadResult{Keys: keys, Records: make([]*neo4j.Record, 0)}
+	size, skipped := 0, 0
+	for res.Next(ctx) {
+		if skipped < opts.Skip {
+			skipped++
+			continue
+		}
+		record := res.Record()
+		if !result.Truncated && opts.MaxRows > 0 && len(result.Records) >= opts.MaxRows {
+			result.Truncated = true
+		}
+		if !result.Truncated && opts.MaxBytes > 0 && opts.RecordSize != nil {
+			size += opts.RecordSize(record)
+			result.Truncated = size > opts.MaxBytes
+		}
+		if result.Truncated {
+			if result.OmittedRows >= maxCountedRows {
+				result.MoreRows = true
+				break
+			}
+			result.OmittedRows++
+			continue
+		}
+		result.Records = append(result.Records, record)
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+	summary, err := res.Consume(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Summary = summaryFromResult(summary)
+
+	return result, nil
 }
 
 // ExecuteWriteQuery executes a write-only Cypher query and returns raw records
 func (s *Neo4jService) ExecuteWriteQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, error) {
-	res, err := neo4j.ExecuteQuery(ctx, s.driver, cypher, params, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase(s.database), neo4j.ExecuteQueryWithWritersRouting())
+	result, err := s.ExecuteWriteQueryWithCounters(ctx, cypher, params)
+	if err != nil {
+		return nil, err
+	}
+	return result.Records, nil
+}
+
+// ExecuteWriteQueryWithCounters executes a write-only Cypher query and returns its records with the updates it made
+func (s *Neo4jService) ExecuteWriteQueryWithCounters(ctx context.Context, cypher string, params map[string]any) (*WriteResult, error) {
+	result := &WriteResult{}
+	_, err := s.runInTransaction(ctx, neo4j.AccessModeWrite, nil, func(tx neo4j.ManagedTransaction) error {
+		res, err := tx.Run(ctx, cypher, params)
+		if err != nil {
+			return err
+		}
+		if result.Keys, err = res.Keys(); err != nil {
+			return err
+		}
+		if result.Records, err = res.Collect(ctx); err != nil {
+			return err
+		}
+		summary, err := res.Consume(ctx)
+		if err != nil {
+			return err
+		}
+		result.Counters = countersFromSummary(summary)
+		result.Summary = summaryFromResult(summary)
+		return nil
+	})
 	if err != nil {
 		wrappedErr := fmt.Errorf("failed to execute write query: %w", err)
 		log.Printf("Error in ExecuteWriteQuery: %v", wrappedErr)
 		return nil, wrappedErr
 	}
 
-	return res.Records, nil
+	return result, nil
 }
 
 // GetQueryType prefixes the provided query with EXPLAIN and returns the query type (e.g. 'r' for read, 'w' for write, 'rw' etc.)
@@ -63,37 +248,107 @@ func (s *Neo4jService) GetQueryType(ctx context.Context, cypher string, params m
 	}
 
 	explainedQuery := strings.Join([]string{"EXPLAIN", cypher}, " ")
-	res, err := neo4j.ExecuteQuery(ctx, s.driver, explainedQuery, params, neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase(s.database))
+	var summary neo4j.ResultSummary
+	_, err := s.runInTransaction(ctx, neo4j.AccessModeWrite, nil, func(tx neo4j.ManagedTransaction) error {
+		res, err := tx.Run(ctx, explainedQuery, params)
+		if err != nil {
+			return err
+		}
+		summary, err = res.Consume(ctx)
+		return err
+	})
 	if err != nil {
 		wrappedErr := fmt.Errorf("error during GetQueryType: %w", err)
 		log.Printf("Error during GetQueryType: %v", wrappedErr)
 		return neo4j.StatementTypeUnknown, wrappedErr
 	}
 
-	if res.Summary == nil {
+	if summary == nil {
 		err := fmt.Errorf("error during GetQueryType: no summary returned for explained query")
 		log.Printf("Error during GetQueryType: %v", err)
 		return neo4j.StatementTypeUnknown, err
 	}
 
-	return res.Summary.StatementType(), nil
+	return summary.StatementType(), nil
+
+}
+
+// ExplainQuery prefixes the provided query with EXPLAIN and returns its plan and the notifications of the server, without running it.
+// Like GetQueryType, the query is planned by a writer so that write queries can be explained too.
+func (s *Neo4jService) ExplainQuery(ctx context.Context, cypher string, params map[string]any) (*ExplainResult, error) {
+	if s.driver == nil {
+		err := fmt.Errorf("neo4j driver is not initialized")
+		log.Printf("Error in ExplainQuery: %v", err)
+		return nil, err
+	}
+
+	var result *ExplainResult
+	_, err := s.runInTransaction(ctx, neo4j.AccessModeWrite, nil, func(tx neo4j.ManagedTransaction) error {
+		res, err := tx.Run(ctx, strings.Join([]string{"EXPLAIN", cypher}, " "), params)
+		if err != nil {
+			return err
+		}
+		summary, err := res.Consume(ctx)
+		if err != nil {
+			return err
+		}
+		result = &ExplainResult{
+			StatementType: statementTypeName(summary.StatementType()),
+			Plan:          planFromSummary(summary.Plan()),
+			Notifications: notificationsFromSummary(summary),
+		}
+		return nil
+	})
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to explain query: %w", err)
+		log.Printf("Error in ExplainQuery: %v", wrappedErr)
+		return nil, wrappedErr
+	}
 
+	return result, nil
 }
 
-// Neo4jRecordsToJSON converts Neo4j records to JSON string
-func (s *Neo4jService) Neo4jRecordsToJSON(records []*neo4j.Record) (string, error) {
-	results := make([]map[string]any, 0)
-	for _, record := range records {
-		recordMap := record.AsMap()
-		results = append(results, recordMap)
+// ProfileQuery prefixes the provided query with PROFILE, runs it in a read transaction that is always rolled back
+// and returns its profiled plan and the notifications of the server. The records of the query are discarded.
+func (s *Neo4jService) ProfileQuery(ctx context.Context, cypher string, params map[string]any) (*ProfileResult, error) {
+	if s.driver == nil {
+		err := fmt.Errorf("neo4j driver is not initialized")
+		log.Printf("Error in ProfileQuery: %v", err)
+		return nil, err
 	}
 
-	formattedResponse, err := json.MarshalIndent(results, "", "  ")
+	var result *ProfileResult
+	err := s.runRolledBack(ctx, neo4j.AccessModeRead, func(tx neo4j.ExplicitTransaction) error {
+		res, err := tx.Run(ctx, strings.Join([]string{"PROFILE", cypher}, " "), params)
+		if err != nil {
+			return err
+		}
+		rows := 0
+		for res.Next(ctx) {
+			rows++
+		}
+		if err := res.Err(); err != nil {
+			return err
+		}
+		summary, err := res.Consume(ctx)
+		if err != nil {
+			return err
+		}
+		plan := profiledPlanFromSummary(summary.Profile())
+		result = &ProfileResult{
+			StatementType: statementTypeName(summary.StatementType()),
+			Rows:          rows,
+			DbHits:        plan.TotalDbHits(),
+			Plan:          plan,
+			Notifications: notificationsFromSummary(summary),
+		}
+		return nil
+	})
 	if err != nil {
-		wrappedErr := fmt.Errorf("failed to format records as JSON: %w", err)
-		log.Printf("Error in Neo4jRecordsToJSON: %v", wrappedErr)
-		return "", wrappedErr
+		wrappedErr := fmt.Errorf("failed to profile query: %w", err)
+		log.Printf("Error in ProfileQuery: %v", wrappedErr)
+		return nil, wrappedErr
 	}
 
-	return string(formattedResponse), nil
+	return result, nil
 }
@@ -0,0 +1,63 @@
+package database_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/database"
+)
+
+// benchmarkRows is the size of the result read by the benchmarks
+const benchmarkRows = 1_000_000
+
+// heapInUse returns the bytes of live heap objects, after a garbage collection
+func heapInUse() uint64 {
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
+
+// benchmarkRead runs read against a result of benchmarkRows records and reports, besides the allocations,
+// the heap still held by what read returns: the memory a tool keeps until it formats its response.
+// The records of the fake result are allocated once, so only what the service holds on to is counted.
+func benchmarkRead(b *testing.B, read func(service *database.Neo4jService) (any, error)) {
+	records := fakeRecords(benchmarkRows)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var retained uint64
+	for b.Loop() {
+		driver := &fakeDriver{keys: []string{"n"}, records: records}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		b.StopTimer()
+		before := heapInUse()
+		b.StartTimer()
+		result, err := read(service)
+		if err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+		b.StopTimer()
+		if after := heapInUse(); after > before {
+			retained = max(retained, after-before)
+		}
+		runtime.KeepAlive(result)
+		b.StartTimer()
+	}
+	b.ReportMetric(float64(retained), "retained-B")
+}
+
+func BenchmarkExecuteReadQuery(b *testing.B) {
+	benchmarkRead(b, func(service *database.Neo4jService) (any, error) {
+		return service.ExecuteReadQuery(context.Background(), "MATCH (n) RETURN n", nil)
+	})
+}
+
+func BenchmarkExecuteReadQueryWithOptions(b *testing.B) {
+	opts := database.ReadOptions{MaxRows: 1000}
+	benchmarkRead(b, func(service *database.Neo4jService) (any, error) {
+		return service.ExecuteReadQueryWithOptions(context.Background(), "MATCH (n) RETURN n", nil, opts)
+	})
+}
@@ -3,7 +3,10 @@ package database_test
 import (
 	"context"
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/neo4j/mcp/internal/database"
 	db "github.com/neo4j/mcp/internal/database/mocks"
@@ -226,3 +229,512 @@ func TestDatabaseService_ExecuteWriteQuery(t *testing.T) {
 		}
 	})
 }
+
+// recordSize returns a RecordSize estimating every record to the given size
+func recordSize(size int) func(*neo4j.Record) int {
+	return func(*neo4j.Record) int { return size }
+}
+
+func TestDatabaseService_ExecuteReadQueryWithOptions(t *testing.T) {
+	tests := []struct {
+		name          string
+		records       int
+		opts          database.ReadOptions
+		wantRecords   int
+		wantTruncated bool
+		wantOmitted   int
+		wantMoreRows  bool
+	}{
+		{name: "no limits", records: 5, wantRecords: 5},
+		{name: "within row limit", records: 5, opts: database.ReadOptions{MaxRows: 5}, wantRecords: 5},
+		{name: "row limit", records: 5, opts: database.ReadOptions{MaxRows: 2}, wantRecords: 2, wantTruncated: true, wantOmitted: 3},
+		{name: "byte limit", records: 5, opts: database.ReadOptions{MaxBytes: 50, RecordSize: recordSize(20)}, wantRecords: 2, wantTruncated: true, wantOmitted: 3},
+		{name: "byte limit smaller than a record", records: 5, opts: database.ReadOptions{MaxBytes: 1, RecordSize: recordSize(20)}, wantRecords: 0, wantTruncated: true, wantOmitted: 5},
+		{name: "within byte limit", records: 5, opts: database.ReadOptions{MaxBytes: 50, RecordSize: recordSize(10)}, wantRecords: 5},
+		{name: "byte limit without record size", records: 5, opts: database.ReadOptions{MaxBytes: 1}, wantRecords: 5},
+		{name: "empty result", records: 0, opts: database.ReadOptions{MaxRows: 1}, wantRecords: 0},
+		{name: "skip", records: 5, opts: database.ReadOptions{Skip: 3}, wantRecords: 2},
+		{name: "skip and row limit", records: 5, opts: database.ReadOptions{Skip: 1, MaxRows: 2}, wantRecords: 2, wantTruncated: true, wantOmitted: 2},
+		{name: "skip past the end", records: 5, opts: database.ReadOptions{Skip: 10, MaxRows: 2}, wantRecords: 0},
+		{name: "omitted rows counted up to a cap", records: 1500, opts: database.ReadOptions{MaxRows: 2}, wantRecords: 2, wantTruncated: true, wantOmitted: 1000, wantMoreRows: true},
+		{name: "omitted rows at the cap", records: 1002, opts: database.ReadOptions{MaxRows: 2}, wantRecords: 2, wantTruncated: true, wantOmitted: 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			driver := &fakeDriver{keys: []string{"n"}, records: fakeRecords(tt.records)}
+			service, err := database.NewNeo4jService(driver, "neo4j")
+			if err != nil {
+				t.Fatalf("failed to create service: %v", err)
+			}
+
+			result, err := service.ExecuteReadQueryWithOptions(context.Background(), "MATCH (n) RETURN n", nil, tt.opts)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if len(result.Records) != tt.wantRecords {
+				t.Errorf("expected %d records, got %d", tt.wantRecords, len(result.Records))
+			}
+			if result.Truncated != tt.wantTruncated {
+				t.Errorf("expected truncated %v, got %v", tt.wantTruncated, result.Truncated)
+			}
+			if result.OmittedRows != tt.wantOmitted {
+				t.Errorf("expected %d omitted rows, got %d", tt.wantOmitted, result.OmittedRows)
+			}
+			if result.MoreRows != tt.wantMoreRows {
+				t.Errorf("expected more rows %v, got %v", tt.wantMoreRows, result.MoreRows)
+			}
+			if len(result.Keys) != 1 || result.Keys[0] != "n" {
+				t.Errorf("expected keys [n], got %v", result.Keys)
+			}
+		})
+	}
+
+	t.Run("uses the database and credentials of the context", func(t *testing.T) {
+		driver := &fakeDriver{}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		token := neo4j.BearerAuth("token")
+		ctx := database.WithAuthToken(database.WithDatabase(context.Background(), "movies"), token)
+		if _, err := service.ExecuteReadQueryWithOptions(ctx, "RETURN 1", nil, database.ReadOptions{}); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+
+		if driver.sessionConfig.DatabaseName != "movies" {
+			t.Errorf("expected database movies, got %q", driver.sessionConfig.DatabaseName)
+		}
+		if driver.sessionConfig.AccessMode != neo4j.AccessModeRead {
+			t.Errorf("expected read access mode, got %v", driver.sessionConfig.AccessMode)
+		}
+		if driver.sessionConfig.Auth == nil || driver.sessionConfig.Auth.Tokens["credentials"] != "token" {
+			t.Errorf("expected bearer credentials, got %+v", driver.sessionConfig.Auth)
+		}
+	})
+
+	t.Run("skipped records are not returned", func(t *testing.T) {
+		driver := &fakeDriver{records: fakeRecords(5)}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		result, err := service.ExecuteReadQueryWithOptions(context.Background(), "MATCH (n) RETURN n", nil, database.ReadOptions{Skip: 2, MaxRows: 1})
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if len(result.Records) != 1 || result.Records[0].Values[0] != int64(2) {
+			t.Errorf("expected the third record, got %v", result.Records)
+		}
+	})
+
+	t.Run("bookmarks", func(t *testing.T) {
+		driver := &fakeDriver{bookmarks: []string{"bookmark-2"}}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		result, err := service.ExecuteReadQueryWithOptions(context.Background(), "RETURN 1", nil, database.ReadOptions{Bookmarks: []string{"bookmark-1"}})
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if got := neo4j.BookmarksToRawValues(driver.sessionConfig.Bookmarks); len(got) != 1 || got[0] != "bookmark-1" {
+			t.Errorf("expected session bookmarks [bookmark-1], got %v", got)
+		}
+		if len(result.Bookmarks) != 1 || result.Bookmarks[0] != "bookmark-2" {
+			t.Errorf("expected result bookmarks [bookmark-2], got %v", result.Bookmarks)
+		}
+	})
+
+	t.Run("uses the transaction configuration of the context", func(t *testing.T) {
+		driver := &fakeDriver{}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		ctx := database.WithTxTimeout(context.Background(), 5*time.Second)
+		ctx = database.WithTxMetadata(ctx, map[string]any{"tool": "read-cypher"})
+		ctx = database.WithTxMetadata(ctx, map[string]any{"requestId": "1"})
+		if _, err := service.ExecuteReadQueryWithOptions(ctx, "RETURN 1", nil, database.ReadOptions{}); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+
+		if driver.txConfig.Timeout != 5*time.Second {
+			t.Errorf("expected timeout 5s, got %s", driver.txConfig.Timeout)
+		}
+		want := map[string]any{"tool": "read-cypher", "requestId": "1"}
+		if !reflect.DeepEqual(driver.txConfig.Metadata, want) {
+			t.Errorf("expected metadata %v, got %v", want, driver.txConfig.Metadata)
+		}
+	})
+
+	t.Run("result error", func(t *testing.T) {
+		driver := &fakeDriver{records: fakeRecords(3), err: errors.New("connection lost")}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		if _, err := service.ExecuteReadQueryWithOptions(context.Background(), "MATCH (n) RETURN n", nil, database.ReadOptions{MaxRows: 1}); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestDatabaseService_StreamedQueries(t *testing.T) {
+	t.Run("read query collects the records of a read transaction", func(t *testing.T) {
+		driver := &fakeDriver{keys: []string{"n"}, records: fakeRecords(3)}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		ctx := database.WithDatabase(context.Background(), "movies")
+		records, err := service.ExecuteReadQuery(ctx, "MATCH (n) RETURN n", nil)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if len(records) != 3 {
+			t.Errorf("expected 3 records, got %d", len(records))
+		}
+		if driver.sessionConfig.AccessMode != neo4j.AccessModeRead || driver.sessionConfig.DatabaseName != "movies" {
+			t.Errorf("expected a read session on movies, got %+v", driver.sessionConfig)
+		}
+		if driver.sessionConfig.BookmarkManager != driver.ExecuteQueryBookmarkManager() {
+			t.Error("expected the session to share the bookmark manager of the driver")
+		}
+		if !driver.committed || !driver.closed {
+			t.Errorf("expected the transaction committed and the session closed, got committed=%v closed=%v", driver.committed, driver.closed)
+		}
+	})
+
+	t.Run("read query failure", func(t *testing.T) {
+		driver := &fakeDriver{records: fakeRecords(3), err: errors.New("connection lost")}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		if _, err := service.ExecuteReadQuery(context.Background(), "MATCH (n) RETURN n", nil); err == nil || !strings.Contains(err.Error(), "connection lost") {
+			t.Errorf("expected the error of the result, got: %v", err)
+		}
+	})
+
+	t.Run("query type is read from the summary of the explained query", func(t *testing.T) {
+		driver := &fakeDriver{statementType: neo4j.StatementTypeReadWrite}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		got, err := service.GetQueryType(context.Background(), "MATCH (n) SET n.seen = true", nil)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if got != neo4j.StatementTypeReadWrite {
+			t.Errorf("expected a read-write query, got %v", got)
+		}
+		if driver.cypher != "EXPLAIN MATCH (n) SET n.seen = true" || driver.sessionConfig.AccessMode != neo4j.AccessModeWrite {
+			t.Errorf("expected the query explained by a writer, got %q in %v mode", driver.cypher, driver.sessionConfig.AccessMode)
+		}
+	})
+}
+
+func TestDatabaseService_Cancellation(t *testing.T) {
+	// assertCancelled checks that the query ran with the cancelled ctx, so that the driver stops it, and that the session
+	// was still closed with a live context, so that its connection is reset and the server rolls the transaction back
+	assertCancelled := func(t *testing.T, driver *fakeDriver, err error) {
+		t.Helper()
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got: %v", err)
+		}
+		if driver.committed {
+			t.Error("expected the transaction not to be committed")
+		}
+		if driver.runCtx == nil || !errors.Is(driver.runCtx.Err(), context.Canceled) {
+			t.Error("expected the query to run with the cancelled context")
+		}
+		if !driver.closed || driver.closeErr != nil {
+			t.Errorf("expected the session to be closed with a live context, got closed=%v and %v", driver.closed, driver.closeErr)
+		}
+		if !driver.closeDeadline {
+			t.Error("expected the session to be closed with a bounded context")
+		}
+	}
+
+	t.Run("read transaction is committed when the query succeeds", func(t *testing.T) {
+		driver := &fakeDriver{records: fakeRecords(3)}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		if _, err := service.ExecuteReadQueryWithOptions(context.Background(), "MATCH (n) RETURN n", nil, database.ReadOptions{}); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if !driver.committed {
+			t.Error("expected the transaction to be committed")
+		}
+		if !driver.closed {
+			t.Error("expected the session to be closed")
+		}
+	})
+
+	t.Run("read query is cancelled while streaming", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		driver := &fakeDriver{records: fakeRecords(3), cancel: cancel}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		_, err := service.ExecuteReadQueryWithOptions(ctx, "MATCH (n) RETURN n", nil, database.ReadOptions{})
+		assertCancelled(t, driver, err)
+	})
+
+	t.Run("write transaction is committed when the query succeeds", func(t *testing.T) {
+		driver := &fakeDriver{records: fakeRecords(2)}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		records, err := service.ExecuteWriteQuery(context.Background(), "CREATE (n) RETURN n", nil)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if len(records) != 2 {
+			t.Errorf("expected 2 records, got %d", len(records))
+		}
+		if driver.sessionConfig.AccessMode != neo4j.AccessModeWrite {
+			t.Errorf("expected write access mode, got %v", driver.sessionConfig.AccessMode)
+		}
+		if !driver.committed {
+			t.Error("expected the transaction to be committed")
+		}
+	})
+
+	t.Run("write query is cancelled while streaming", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		driver := &fakeDriver{records: fakeRecords(3), cancel: cancel}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		_, err := service.ExecuteWriteQuery(ctx, "MATCH (n) SET n.seen = true RETURN n", nil)
+		assertCancelled(t, driver, err)
+	})
+
+	t.Run("query is cancelled before it runs", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		driver := &fakeDriver{records: fakeRecords(3)}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		_, err := service.ExecuteWriteQuery(ctx, "CREATE (n)", nil)
+		assertCancelled(t, driver, err)
+	})
+}
+
+func TestDatabaseService_Retries(t *testing.T) {
+	t.Run("read query is retried after a transient error", func(t *testing.T) {
+		driver := &fakeDriver{records: fakeRecords(3), transientErrors: 1}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		result, err := service.ExecuteReadQueryWithOptions(context.Background(), "MATCH (n) RETURN n", nil, database.ReadOptions{MaxRows: 2})
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if driver.attempts != 2 || len(result.Records) != 2 || result.OmittedRows != 1 {
+			t.Errorf("expected the result of the second attempt, got %d attempts and %+v", driver.attempts, result)
+		}
+	})
+
+	t.Run("write query is retried after a transient error", func(t *testing.T) {
+		driver := &fakeDriver{records: fakeRecords(1), transientErrors: 1}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		records, err := service.ExecuteWriteQuery(context.Background(), "CREATE (n) RETURN n", nil)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if driver.attempts != 2 || len(records) != 1 || !driver.committed {
+			t.Errorf("expected the second attempt to be committed, got %d attempts, %d records, committed=%v", driver.attempts, len(records), driver.committed)
+		}
+	})
+
+	t.Run("read query is not retried after other errors", func(t *testing.T) {
+		driver := &fakeDriver{records: fakeRecords(3), err: errors.New("connection lost")}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		if _, err := service.ExecuteReadQueryWithOptions(context.Background(), "MATCH (n) RETURN n", nil, database.ReadOptions{}); err == nil {
+			t.Error("expected error, got nil")
+		}
+		if driver.attempts != 1 {
+			t.Errorf("expected a single attempt, got %d", driver.attempts)
+		}
+	})
+}
+
+func TestDatabaseService_ExecuteWriteQueryWithCounters(t *testing.T) {
+	counters := database.Counters{NodesCreated: 1, LabelsAdded: 1, PropertiesSet: 2}
+	driver := &fakeDriver{keys: []string{"n"}, records: fakeRecords(1), counters: counters}
+	service, _ := database.NewNeo4jService(driver, "neo4j")
+
+	result, err := service.ExecuteWriteQueryWithCounters(context.Background(), "CREATE (n:Person {name: 'Alice', age: 30}) RETURN n", nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(result.Records) != 1 {
+		t.Errorf("expected 1 record, got %d", len(result.Records))
+	}
+	if len(result.Keys) != 1 || result.Keys[0] != "n" {
+		t.Errorf("expected keys [n], got %v", result.Keys)
+	}
+	if result.Counters != counters {
+		t.Errorf("expected counters %+v, got %+v", counters, result.Counters)
+	}
+	if !result.Counters.SchemaChanged() {
+		t.Error("expected adding a label to change the schema")
+	}
+	if !driver.committed {
+		t.Error("expected the transaction to be committed")
+	}
+}
+
+func TestCountersSchemaChanged(t *testing.T) {
+	tests := []struct {
+		name     string
+		counters database.Counters
+		want     bool
+	}{
+		{name: "no updates", counters: database.Counters{}, want: false},
+		{name: "properties set", counters: database.Counters{PropertiesSet: 3, RelationshipsCreated: 1}, want: false},
+		{name: "label removed", counters: database.Counters{LabelsRemoved: 1}, want: true},
+		{name: "index added", counters: database.Counters{IndexesAdded: 1}, want: true},
+		{name: "constraint removed", counters: database.Counters{ConstraintsRemoved: 1}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.counters.SchemaChanged(); got != tt.want {
+				t.Errorf("SchemaChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDatabaseService_ExplainQuery(t *testing.T) {
+	driver := &fakeDriver{
+		statementType: neo4j.StatementTypeReadOnly,
+		plan: &fakePlan{
+			operator:    "ProduceResults@neo4j",
+			arguments:   map[string]any{"EstimatedRows": 10.0, "planner": "COST"},
+			identifiers: []string{"n"},
+			children: []neo4j.Plan{
+				&fakePlan{operator: "AllNodesScan@neo4j", arguments: map[string]any{"EstimatedRows": int64(10)}, identifiers: []string{"n"}},
+			},
+		},
+		notifications: []neo4j.Notification{
+			&fakeNotification{code: "Neo.ClientNotification.Statement.CartesianProduct", title: "Cartesian product", severity: "WARNING", category: "PERFORMANCE"},
+		},
+	}
+	service, _ := database.NewNeo4jService(driver, "neo4j")
+
+	result, err := service.ExplainQuery(context.Background(), "MATCH (n) RETURN n", nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if driver.cypher != "EXPLAIN MATCH (n) RETURN n" {
+		t.Errorf("expected the query to be explained, got %q", driver.cypher)
+	}
+	if result.StatementType != "READ_ONLY" {
+		t.Errorf("expected statement type READ_ONLY, got %q", result.StatementType)
+	}
+
+	plan := result.Plan
+	if plan == nil || plan.Operator != "ProduceResults@neo4j" || plan.EstimatedRows != 10 || plan.Arguments["planner"] != "COST" {
+		t.Fatalf("unexpected plan root: %+v", plan)
+	}
+	if _, ok := plan.Arguments["EstimatedRows"]; ok {
+		t.Error("expected EstimatedRows to be moved out of the arguments")
+	}
+	if len(plan.Children) != 1 || plan.Children[0].Operator != "AllNodesScan@neo4j" || plan.Children[0].EstimatedRows != 10 {
+		t.Errorf("unexpected plan children: %+v", plan.Children)
+	}
+
+	want := database.Notification{Code: "Neo.ClientNotification.Statement.CartesianProduct", Title: "Cartesian product", Severity: "WARNING", Category: "PERFORMANCE"}
+	if len(result.Notifications) != 1 || result.Notifications[0] != want {
+		t.Errorf("expected notifications [%+v], got %+v", want, result.Notifications)
+	}
+}
+
+func TestDatabaseService_ProfileQuery(t *testing.T) {
+	driver := &fakeDriver{
+		records:       fakeRecords(3),
+		statementType: neo4j.StatementTypeReadOnly,
+		profile: &fakeProfiledPlan{
+			fakePlan: fakePlan{operator: "ProduceResults@neo4j", arguments: map[string]any{"EstimatedRows": 3.0, "Rows": int64(3), "runtime": "PIPELINED"}, identifiers: []string{"n"}},
+			dbHits:   0, records: 3, pageCacheHits: 1, pageCacheMisses: 1, time: 1000,
+			children: []neo4j.ProfiledPlan{
+				&fakeProfiledPlan{
+					fakePlan: fakePlan{operator: "AllNodesScan@neo4j", arguments: map[string]any{"EstimatedRows": 3.0, "DbHits": int64(4)}, identifiers: []string{"n"}},
+					dbHits:   4, records: 3, pageCacheHits: 2, time: 2000,
+				},
+			},
+		},
+	}
+	service, _ := database.NewNeo4jService(driver, "neo4j")
+
+	result, err := service.ProfileQuery(context.Background(), "MATCH (n) RETURN n", nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if driver.cypher != "PROFILE MATCH (n) RETURN n" {
+		t.Errorf("expected the query to be profiled, got %q", driver.cypher)
+	}
+	if driver.sessionConfig.AccessMode != neo4j.AccessModeRead {
+		t.Errorf("expected a read session, got access mode %v", driver.sessionConfig.AccessMode)
+	}
+	if driver.committed || !driver.rolledBack {
+		t.Error("expected the transaction to be rolled back")
+	}
+	if result.StatementType != "READ_ONLY" || result.Rows != 3 || result.DbHits != 4 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	root := result.Plan
+	want := database.ProfiledPlan{Operator: "ProduceResults@neo4j", EstimatedRows: 3, Rows: 3, PageCacheHits: 1, PageCacheMisses: 1, PageCacheHitRatio: 0.5, TimeNs: 1000}
+	if root == nil || root.Operator != want.Operator || root.EstimatedRows != want.EstimatedRows || root.Rows != want.Rows ||
+		root.PageCacheHits != want.PageCacheHits || root.PageCacheMisses != want.PageCacheMisses ||
+		root.PageCacheHitRatio != want.PageCacheHitRatio || root.TimeNs != want.TimeNs {
+		t.Fatalf("expected plan root %+v, got %+v", want, root)
+	}
+	if len(root.Arguments) != 1 || root.Arguments["runtime"] != "PIPELINED" {
+		t.Errorf("expected only the arguments not reported by fields, got %v", root.Arguments)
+	}
+	if len(root.Children) != 1 || root.Children[0].DbHits != 4 || len(root.Children[0].Arguments) != 0 {
+		t.Errorf("unexpected plan children: %+v", root.Children)
+	}
+}
+
+func TestDatabaseService_ProfileQueryFailure(t *testing.T) {
+	driver := &fakeDriver{records: fakeRecords(1), err: errors.New("Writing in read access mode not allowed")}
+	service, _ := database.NewNeo4jService(driver, "neo4j")
+
+	if _, err := service.ProfileQuery(context.Background(), "CREATE (n)", nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	if driver.committed || !driver.rolledBack {
+		t.Error("expected the transaction to be rolled back")
+	}
+}
+
+func TestDatabaseService_Summary(t *testing.T) {
+	notification := &fakeNotification{code: "Neo.ClientNotification.Statement.FeatureDeprecationWarning", title: "Deprecated", severity: "WARNING", category: "DEPRECATION"}
+	want := database.Summary{
+		Notifications:        []database.Notification{{Code: notification.code, Title: notification.title, Severity: "WARNING", Category: "DEPRECATION"}},
+		ResultAvailableAfter: 2 * time.Millisecond,
+		ResultConsumedAfter:  3 * time.Millisecond,
+	}
+	check := func(t *testing.T, got database.Summary) {
+		t.Helper()
+		if len(got.Notifications) != 1 || got.Notifications[0] != want.Notifications[0] ||
+			got.ResultAvailableAfter != want.ResultAvailableAfter || got.ResultConsumedAfter != want.ResultConsumedAfter {
+			t.Errorf("expected summary %+v, got %+v", want, got)
+		}
+	}
+
+	t.Run("read query", func(t *testing.T) {
+		driver := &fakeDriver{records: fakeRecords(3), notifications: []neo4j.Notification{notification}}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		result, err := service.ExecuteReadQueryWithOptions(context.Background(), "MATCH (n) RETURN id(n)", nil, database.ReadOptions{MaxRows: 1})
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		check(t, result.Summary)
+	})
+
+	t.Run("write query", func(t *testing.T) {
+		driver := &fakeDriver{records: fakeRecords(1), notifications: []neo4j.Notification{notification}}
+		service, _ := database.NewNeo4jService(driver, "neo4j")
+
+		result, err := service.ExecuteWriteQueryWithCounters(context.Background(), "CREATE (n) RETURN id(n)", nil)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		check(t, result.Summary)
+	})
+}
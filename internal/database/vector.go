@@ -0,0 +1,121 @@
+package database
+
+import (
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Vector stands for an embedding vector left out of a record by ElideVectors, serialized as {"type": "vector", "dims": N}
+type Vector struct {
+	Dims int
+}
+
+// VectorOptions select the values ElideVectors replaces with a Vector
+type VectorOptions struct {
+	// MinDimensions is the minimum number of elements of the numeric lists replaced, 0 only replaces the lists named by Properties
+	MinDimensions int
+	// Properties are the names of the properties, map keys and columns whose numeric lists are replaced whatever their length
+	Properties []string
+}
+
+// Enabled reports whether opts replace any value
+func (o VectorOptions) Enabled() bool {
+	return o.MinDimensions > 0 || len(o.Properties) > 0
+}
+
+// ElideVectors returns records with the numeric lists selected by opts, within nodes, relationships, paths, lists and maps,
+// replaced with a Vector. Records holding no such list are returned as is.
+func ElideVectors(records []*neo4j.Record, opts VectorOptions) []*neo4j.Record {
+	if !opts.Enabled() {
+		return records
+	}
+	elided := make([]*neo4j.Record, len(records))
+	for i, record := range records {
+		elided[i] = ElideRecordVectors(record, opts)
+	}
+	return elided
+}
+
+// ElideRecordVectors returns a copy of record with the numeric lists selected by opts replaced with a Vector
+func ElideRecordVectors(record *neo4j.Record, opts VectorOptions) *neo4j.Record {
+	if !opts.Enabled() {
+		return record
+	}
+	e := vectorElider{opts: opts, properties: make(map[string]bool, len(opts.Properties))}
+	for _, property := range opts.Properties {
+		e.properties[property] = true
+	}
+
+	values := make([]any, len(record.Values))
+	for i, value := range record.Values {
+		named := i < len(record.Keys) && e.properties[record.Keys[i]]
+		values[i] = e.value(value, named)
+	}
+	return &neo4j.Record{Keys: record.Keys, Values: values}
+}
+
+type vectorElider struct {
+	opts       VectorOptions
+	properties map[string]bool
+}
+
+// value returns value with its vectors replaced, named telling whether value is held by one of the vector properties
+func (e vectorElider) value(value any, named bool) any {
+	switch v := value.(type) {
+	case []any:
+		if dims, ok := numericListLength(v); ok && dims > 0 && (named || (e.opts.MinDimensions > 0 && dims >= e.opts.MinDimensions)) {
+			return Vector{Dims: dims}
+		}
+		elided := make([]any, len(v))
+		for i, element := range v {
+			elided[i] = e.value(element, false)
+		}
+		return elided
+	case []float64:
+		if len(v) > 0 && (named || (e.opts.MinDimensions > 0 && len(v) >= e.opts.MinDimensions)) {
+			return Vector{Dims: len(v)}
+		}
+		return v
+	case map[string]any:
+		return e.propertyMap(v)
+	case neo4j.Node:
+		v.Props = e.propertyMap(v.Props)
+		return v
+	case neo4j.Relationship:
+		v.Props = e.propertyMap(v.Props)
+		return v
+	case neo4j.Path:
+		nodes := make([]neo4j.Node, len(v.Nodes))
+		for i, node := range v.Nodes {
+			node.Props = e.propertyMap(node.Props)
+			nodes[i] = node
+		}
+		relationships := make([]neo4j.Relationship, len(v.Relationships))
+		for i, relationship := range v.Relationships {
+			relationship.Props = e.propertyMap(relationship.Props)
+			relationships[i] = relationship
+		}
+		return neo4j.Path{Nodes: nodes, Relationships: relationships}
+	default:
+		return value
+	}
+}
+
+func (e vectorElider) propertyMap(properties map[string]any) map[string]any {
+	elided := make(map[string]any, len(properties))
+	for key, property := range properties {
+		elided[key] = e.value(property, e.properties[key])
+	}
+	return elided
+}
+
+// numericListLength returns the length of list when all its elements are numbers
+func numericListLength(list []any) (int, bool) {
+	for _, element := range list {
+		switch element.(type) {
+		case float64, int64:
+		default:
+			return 0, false
+		}
+	}
+	return len(list), true
+}
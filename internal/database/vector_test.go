@@ -0,0 +1,103 @@
+package database_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// vector returns a numeric list of the given length
+func vector(dims int) []any {
+	v := make([]any, dims)
+	for i := range v {
+		v[i] = float64(i) / 10
+	}
+	return v
+}
+
+func TestElideVectors(t *testing.T) {
+	opts := database.VectorOptions{MinDimensions: 8, Properties: []string{"embedding"}}
+
+	tests := []struct {
+		name  string
+		key   string
+		value any
+		want  any
+	}{
+		{name: "large numeric list", key: "v", value: vector(8), want: database.Vector{Dims: 8}},
+		{name: "small numeric list", key: "v", value: vector(3), want: vector(3)},
+		{name: "integer list", key: "v", value: []any{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(8)}, want: database.Vector{Dims: 8}},
+		{name: "mixed list", key: "v", value: append(vector(8), "x"), want: append(vector(8), "x")},
+		{name: "empty list", key: "embedding", value: []any{}, want: []any{}},
+		{name: "float slice", key: "v", value: []float64{1, 2, 3, 4, 5, 6, 7, 8}, want: database.Vector{Dims: 8}},
+		{name: "vector column", key: "embedding", value: vector(3), want: database.Vector{Dims: 3}},
+		{name: "nested lists", key: "v", value: []any{vector(8), vector(2)}, want: []any{database.Vector{Dims: 8}, vector(2)}},
+		{name: "vector map entry", key: "v", value: map[string]any{"embedding": vector(2), "name": "Alice"}, want: map[string]any{"embedding": database.Vector{Dims: 2}, "name": "Alice"}},
+		{
+			name:  "node properties",
+			key:   "n",
+			value: neo4j.Node{ElementId: "4:db:1", Labels: []string{"Doc"}, Props: map[string]any{"embedding": vector(2), "title": "Intro", "scores": vector(8)}},
+			want:  neo4j.Node{ElementId: "4:db:1", Labels: []string{"Doc"}, Props: map[string]any{"embedding": database.Vector{Dims: 2}, "title": "Intro", "scores": database.Vector{Dims: 8}}},
+		},
+		{
+			name:  "relationship properties",
+			key:   "r",
+			value: neo4j.Relationship{ElementId: "5:db:1", Type: "SIMILAR", Props: map[string]any{"embedding": vector(2)}},
+			want:  neo4j.Relationship{ElementId: "5:db:1", Type: "SIMILAR", Props: map[string]any{"embedding": database.Vector{Dims: 2}}},
+		},
+		{
+			name: "path",
+			key:  "p",
+			value: neo4j.Path{
+				Nodes:         []neo4j.Node{{ElementId: "4:db:1", Props: map[string]any{"embedding": vector(2)}}, {ElementId: "4:db:2", Props: map[string]any{}}},
+				Relationships: []neo4j.Relationship{{ElementId: "5:db:1", Props: map[string]any{"weight": 1.5}}},
+			},
+			want: neo4j.Path{
+				Nodes:         []neo4j.Node{{ElementId: "4:db:1", Props: map[string]any{"embedding": database.Vector{Dims: 2}}}, {ElementId: "4:db:2", Props: map[string]any{}}},
+				Relationships: []neo4j.Relationship{{ElementId: "5:db:1", Props: map[string]any{"weight": 1.5}}},
+			},
+		},
+		{name: "other values", key: "v", value: "text", want: "text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &neo4j.Record{Keys: []string{tt.key}, Values: []any{tt.value}}
+			got := database.ElideVectors([]*neo4j.Record{record}, opts)
+			if len(got) != 1 || !reflect.DeepEqual(got[0].Values[0], tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got[0].Values[0])
+			}
+			if !reflect.DeepEqual(record.Values[0], tt.value) {
+				t.Errorf("expected the record to be left unchanged, got %v", record.Values[0])
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		records := []*neo4j.Record{{Keys: []string{"embedding"}, Values: []any{vector(1536)}}}
+		if got := database.ElideVectors(records, database.VectorOptions{}); !reflect.DeepEqual(got, records) {
+			t.Errorf("expected the records unchanged, got %v", got)
+		}
+	})
+
+	t.Run("only listed properties", func(t *testing.T) {
+		record := &neo4j.Record{Keys: []string{"v", "embedding"}, Values: []any{vector(1536), vector(4)}}
+		got := database.ElideRecordVectors(record, database.VectorOptions{Properties: []string{"embedding"}})
+		if !reflect.DeepEqual(got.Values, []any{vector(1536), database.Vector{Dims: 4}}) {
+			t.Errorf("expected only the embedding column to be elided, got %v", got.Values)
+		}
+	})
+}
+
+func TestSerializeVector(t *testing.T) {
+	formatted, err := json.Marshal(database.SerializeValue(database.Vector{Dims: 1536}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `{"dims":1536,"type":"vector"}`; string(formatted) != want {
+		t.Errorf("expected %s, got %s", want, formatted)
+	}
+}
@@ -0,0 +1,73 @@
+// Package embedding turns the text of search tools into the vectors compared with the ones of Neo4j vector indexes.
+package embedding
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Provider computes the embedding vectors of texts
+type Provider interface {
+	// Embed returns the embedding vector of text
+	Embed(ctx context.Context, text string) ([]float64, error)
+}
+
+// Options configure the provider created by New
+type Options struct {
+	URL   string // endpoint of the embedding service, the provider default when empty
+	Model string // name of the embedding model
+	// Timeout bounds each request to the embedding service, defaultTimeout when 0
+	Timeout time.Duration
+}
+
+// defaultTimeout bounds the requests to an embedding service when Options.Timeout is 0
+const defaultTimeout = 30 * time.Second
+
+// Factory creates a provider from its options
+type Factory func(opts Options) (Provider, error)
+
+var (
+	mu        sync.RWMutex
+	factories = map[string]Factory{
+		ProviderOllama: newOllamaProvider,
+	}
+)
+
+// Register makes the providers created by factory selectable by name, replacing the factory previously registered with that name
+func Register(name string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+	factories[name] = factory
+}
+
+// New returns a provider created by the factory registered with the given name
+func New(name string, opts Options) (Provider, error) {
+	mu.RLock()
+	factory, ok := factories[name]
+	if !ok {
+		defer mu.RUnlock()
+		return nil, fmt.Errorf("unknown embedding provider %q, must be one of %s", name, strings.Join(names(), ", "))
+	}
+	mu.RUnlock()
+	return factory(opts)
+}
+
+// Names returns the names of the registered providers, sorted
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return names()
+}
+
+func names() []string {
+	list := make([]string, 0, len(factories))
+	for name := range factories {
+		list = append(list, name)
+	}
+	slices.Sort(list)
+	return list
+}
@@ -0,0 +1,111 @@
+package embedding_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neo4j/mcp/internal/embedding"
+)
+
+// fixedProvider is a provider registered by a test
+type fixedProvider struct {
+	vector []float64
+}
+
+func (p fixedProvider) Embed(context.Context, string) ([]float64, error) {
+	return p.vector, nil
+}
+
+func TestRegister(t *testing.T) {
+	if _, err := embedding.New("remote", embedding.Options{}); err == nil || err.Error() != `unknown embedding provider "remote", must be one of ollama` {
+		t.Errorf("expected an error listing the providers, got: %v", err)
+	}
+
+	embedding.Register("fixed", func(embedding.Options) (embedding.Provider, error) {
+		return fixedProvider{vector: []float64{1, 2}}, nil
+	})
+	provider, err := embedding.New("fixed", embedding.Options{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := provider.Embed(context.Background(), "text"); !reflect.DeepEqual(got, []float64{1, 2}) {
+		t.Errorf("expected the registered provider, got %v", got)
+	}
+
+	if names := embedding.Names(); !reflect.DeepEqual(names, []string{"fixed", "ollama"}) {
+		t.Errorf("expected the registered provider among the names, got %v", names)
+	}
+}
+
+func TestOllamaProvider(t *testing.T) {
+	t.Run("requires a model", func(t *testing.T) {
+		if _, err := embedding.New(embedding.ProviderOllama, embedding.Options{}); err == nil {
+			t.Error("expected an error without model")
+		}
+	})
+
+	t.Run("embeds text", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var request map[string]string
+			if r.URL.Path != "/api/embed" || json.NewDecoder(r.Body).Decode(&request) != nil {
+				http.NotFound(w, r)
+				return
+			}
+			if request["model"] != "nomic-embed-text" || request["input"] != "graph databases" {
+				t.Errorf("unexpected request %v", request)
+			}
+			_, _ = w.Write([]byte(`{"model":"nomic-embed-text","embeddings":[[0.1,0.2,0.3]]}`))
+		}))
+		defer server.Close()
+
+		provider, err := embedding.New(embedding.ProviderOllama, embedding.Options{URL: server.URL + "/", Model: "nomic-embed-text"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got, err := provider.Embed(context.Background(), "graph databases")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, []float64{0.1, 0.2, 0.3}) {
+			t.Errorf("expected the embedding of the response, got %v", got)
+		}
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			<-release
+		}))
+		defer server.Close()
+		defer close(release)
+
+		provider, err := embedding.New(embedding.ProviderOllama, embedding.Options{URL: server.URL, Model: "nomic-embed-text", Timeout: 50 * time.Millisecond})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := provider.Embed(context.Background(), "text"); err == nil || !strings.Contains(err.Error(), "Timeout") {
+			t.Errorf("expected a timeout error, got: %v", err)
+		}
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, `{"error":"model \"missing\" not found"}`, http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		provider, err := embedding.New(embedding.ProviderOllama, embedding.Options{URL: server.URL, Model: "missing"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := provider.Embed(context.Background(), "text"); err == nil || !strings.Contains(err.Error(), "not found") {
+			t.Errorf("expected the error of the response, got: %v", err)
+		}
+	})
+}
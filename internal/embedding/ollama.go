@@ -0,0 +1,85 @@
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// ProviderOllama computes embeddings with a local Ollama server
+	ProviderOllama = "ollama"
+
+	defaultOllamaURL = "http://localhost:11434"
+
+	// maxOllamaErrorBytes is the size of an error response of Ollama kept in the error returned
+	maxOllamaErrorBytes = 512
+)
+
+// ollamaProvider computes embeddings with the /api/embed endpoint of an Ollama server
+type ollamaProvider struct {
+	url    string
+	model  string
+	client *http.Client
+}
+
+func newOllamaProvider(opts Options) (Provider, error) {
+	if opts.Model == "" {
+		return nil, fmt.Errorf("the %s embedding provider requires a model", ProviderOllama)
+	}
+	url := opts.URL
+	if url == "" {
+		url = defaultOllamaURL
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &ollamaProvider{url: strings.TrimSuffix(url, "/"), model: opts.Model, client: &http.Client{Timeout: timeout}}, nil
+}
+
+type ollamaEmbedRequest struct {
+	Model string `json:"model"`
+	Input string `json:"input"`
+}
+
+type ollamaEmbedResponse struct {
+	Embeddings [][]float64 `json:"embeddings"`
+}
+
+// Embed returns the embedding vector of text computed by the model of the provider
+func (p *ollamaProvider) Embed(ctx context.Context, text string) ([]float64, error) {
+	body, err := json.Marshal(ollamaEmbedRequest{Model: p.model, Input: text})
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url+"/api/embed", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach the %s embedding provider: %w", ProviderOllama, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, maxOllamaErrorBytes))
+		return nil, fmt.Errorf("the %s embedding provider returned %s: %s", ProviderOllama, response.Status, strings.TrimSpace(string(message)))
+	}
+
+	var embedded ollamaEmbedResponse
+	if err := json.NewDecoder(response.Body).Decode(&embedded); err != nil {
+		return nil, fmt.Errorf("failed to decode the response of the %s embedding provider: %w", ProviderOllama, err)
+	}
+	if len(embedded.Embeddings) == 0 || len(embedded.Embeddings[0]) == 0 {
+		return nil, fmt.Errorf("the %s embedding provider returned no embedding", ProviderOllama)
+	}
+	return embedded.Embeddings[0], nil
+}
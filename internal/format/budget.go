@@ -0,0 +1,238 @@
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// charsPerToken is the average number of characters of a token, used to estimate the tokens of a text
+const charsPerToken = 4
+
+// EstimateTokens returns the approximate number of tokens of text
+func EstimateTokens(text string) int {
+	return (len(text) + charsPerToken - 1) / charsPerToken
+}
+
+// Actions taken on the values elided to fit a token budget
+const (
+	ElisionPreviewed  = "previewed"  // long string replaced with its beginning
+	ElisionSummarized = "summarized" // long list replaced with its first items and the number of items left out
+	ElisionDropped    = "dropped"    // large property value left out of its node or relationship
+)
+
+// Elision reports values shortened or left out at the same location of every record
+type Elision struct {
+	// Path locates the values within a record: the column, followed by .name for properties and map entries
+	// and by [] for list elements, e.g. n.bio or friends[].name
+	Path   string `json:"path"`
+	Action string `json:"action"` // one of the Elision constants
+	Count  int    `json:"count"`  // number of values elided at Path
+}
+
+// BudgetResult holds records formatted within a token budget
+type BudgetResult struct {
+	Text string
+	// OmittedRecords is the number of trailing records left out to fit the budget
+	OmittedRecords int
+	Tokens         int // estimated number of tokens of Text
+	Elisions       []Elision
+}
+
+// shapingLevel limits the values of records, a zero list length summarizing every list
+type shapingLevel struct {
+	maxStringLength   int // characters kept of strings
+	maxListLength     int // elements kept of lists
+	maxPropertyLength int // formatted size of the property values kept, in bytes
+}
+
+// shapingLevels are applied in turn until the formatted records fit the budget
+var shapingLevels = []shapingLevel{
+	{maxStringLength: 1000, maxListLength: 50, maxPropertyLength: 2000},
+	{maxStringLength: 256, maxListLength: 10, maxPropertyLength: 512},
+	{maxStringLength: 64, maxListLength: 0, maxPropertyLength: 128},
+}
+
+// FormatWithinBudget formats records with formatter in approximately maxTokens tokens, 0 disabling the budget.
+// Records over budget have their long strings, long lists and large property values shortened, more and more aggressively;
+// when that is not enough, the trailing records are left out.
+func FormatWithinBudget(formatter RecordFormatter, keys []string, records []*neo4j.Record, maxTokens int) (*BudgetResult, error) {
+	text, err := formatter.Format(keys, records)
+	if err != nil {
+		return nil, err
+	}
+	if maxTokens <= 0 || EstimateTokens(text) <= maxTokens {
+		return &BudgetResult{Text: text, Tokens: EstimateTokens(text)}, nil
+	}
+
+	var s *shaper
+	var shaped []*neo4j.Record
+	for _, level := range shapingLevels {
+		s = newShaper(level)
+		shaped = s.records(records)
+		if text, err = formatter.Format(keys, shaped); err != nil {
+			return nil, err
+		}
+		if EstimateTokens(text) <= maxTokens {
+			return s.result(text, 0), nil
+		}
+	}
+
+	// Leave out the trailing records, estimating from the size of each one how many fit
+	empty, err := formatter.Format(keys, nil)
+	if err != nil {
+		return nil, err
+	}
+	size, n := len(empty), 0
+	for n < len(shaped) && size+formatter.RecordSize(shaped[n]) <= maxTokens*charsPerToken {
+		size += formatter.RecordSize(shaped[n])
+		n++
+	}
+	for {
+		if text, err = formatter.Format(keys, shaped[:n]); err != nil {
+			return nil, err
+		}
+		if n == 0 || EstimateTokens(text) <= maxTokens {
+			break
+		}
+		n--
+	}
+
+	// Only report the elisions of the records returned
+	s = newShaper(shapingLevels[len(shapingLevels)-1])
+	s.records(records[:n])
+	return s.result(text, len(records)-n), nil
+}
+
+// elisionKey identifies the elisions reported together
+type elisionKey struct {
+	path   string
+	action string
+}
+
+// shaper shortens the values of records to the limits of a shaping level, counting the values it elides
+type shaper struct {
+	level  shapingLevel
+	counts map[elisionKey]int
+}
+
+func newShaper(level shapingLevel) *shaper {
+	return &shaper{level: level, counts: make(map[elisionKey]int)}
+}
+
+func (s *shaper) elide(path, action string) {
+	s.counts[elisionKey{path: path, action: action}]++
+}
+
+// result returns the records formatted as text with the elisions counted, sorted by path
+func (s *shaper) result(text string, omittedRecords int) *BudgetResult {
+	result := &BudgetResult{Text: text, OmittedRecords: omittedRecords, Tokens: EstimateTokens(text), Elisions: make([]Elision, 0, len(s.counts))}
+	for key, count := range s.counts {
+		result.Elisions = append(result.Elisions, Elision{Path: key.path, Action: key.action, Count: count})
+	}
+	sort.Slice(result.Elisions, func(i, j int) bool {
+		a, b := result.Elisions[i], result.Elisions[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Action < b.Action
+	})
+	return result
+}
+
+// records returns copies of records with their values shortened
+func (s *shaper) records(records []*neo4j.Record) []*neo4j.Record {
+	shaped := make([]*neo4j.Record, len(records))
+	for i, record := range records {
+		values := make([]any, len(record.Values))
+		for j, value := range record.Values {
+			path := ""
+			if j < len(record.Keys) {
+				path = record.Keys[j]
+			}
+			values[j] = s.value(path, value)
+		}
+		shaped[i] = &neo4j.Record{Keys: record.Keys, Values: values}
+	}
+	return shaped
+}
+
+// value returns value with its long strings and lists shortened and the large properties of its nodes and relationships left out
+func (s *shaper) value(path string, value any) any {
+	switch v := value.(type) {
+	case string:
+		if utf8.RuneCountInString(v) <= s.level.maxStringLength {
+			return v
+		}
+		s.elide(path, ElisionPreviewed)
+		runes := []rune(v)
+		return fmt.Sprintf("%s... [%d more chars]", string(runes[:s.level.maxStringLength]), len(runes)-s.level.maxStringLength)
+	case []any:
+		if len(v) <= s.level.maxListLength {
+			return s.list(path, v)
+		}
+		s.elide(path, ElisionSummarized)
+		if s.level.maxListLength == 0 {
+			return fmt.Sprintf("[... %d items]", len(v))
+		}
+		kept := s.list(path, v[:s.level.maxListLength])
+		return append(kept, fmt.Sprintf("[... %d more items]", len(v)-s.level.maxListLength))
+	case map[string]any:
+		shaped := make(map[string]any, len(v))
+		for key, element := range v {
+			shaped[key] = s.value(path+"."+key, element)
+		}
+		return shaped
+	case neo4j.Node:
+		v.Props = s.properties(path, v.Props)
+		return v
+	case neo4j.Relationship:
+		v.Props = s.properties(path, v.Props)
+		return v
+	case neo4j.Path:
+		nodes := make([]neo4j.Node, len(v.Nodes))
+		for i, node := range v.Nodes {
+			node.Props = s.properties(path+".nodes[]", node.Props)
+			nodes[i] = node
+		}
+		relationships := make([]neo4j.Relationship, len(v.Relationships))
+		for i, relationship := range v.Relationships {
+			relationship.Props = s.properties(path+".relationships[]", relationship.Props)
+			relationships[i] = relationship
+		}
+		return neo4j.Path{Nodes: nodes, Relationships: relationships}
+	default:
+		return value
+	}
+}
+
+func (s *shaper) list(path string, list []any) []any {
+	shaped := make([]any, len(list))
+	for i, element := range list {
+		shaped[i] = s.value(path+"[]", element)
+	}
+	return shaped
+}
+
+// properties returns the shortened properties of a node or relationship, without the ones still too large
+func (s *shaper) properties(path string, properties map[string]any) map[string]any {
+	shaped := make(map[string]any, len(properties))
+	for key, property := range properties {
+		// The values shortened within a property left out are not reported
+		inner := newShaper(s.level)
+		property = inner.value(path+"."+key, property)
+		if formatted, err := json.Marshal(database.SerializeValue(property)); err != nil || len(formatted) > s.level.maxPropertyLength {
+			s.elide(path+"."+key, ElisionDropped)
+			continue
+		}
+		for k, count := range inner.counts {
+			s.counts[k] += count
+		}
+		shaped[key] = property
+	}
+	return shaped
+}
@@ -0,0 +1,140 @@
+package format_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/format"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestEstimateTokens(t *testing.T) {
+	for text, want := range map[string]int{"": 0, "abc": 1, "abcd": 1, "abcde": 2} {
+		if got := format.EstimateTokens(text); got != want {
+			t.Errorf("EstimateTokens(%q) = %d, expected %d", text, got, want)
+		}
+	}
+}
+
+func TestFormatWithinBudget(t *testing.T) {
+	compact, err := format.Get("json-compact")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	person := func(bio string, tags int) *neo4j.Record {
+		list := make([]any, tags)
+		for i := range list {
+			list[i] = "tag"
+		}
+		node := neo4j.Node{ElementId: "4:db:1", Labels: []string{"Person"}, Props: map[string]any{"name": "Alice", "bio": bio}}
+		return &neo4j.Record{Keys: []string{"n", "tags"}, Values: []any{node, list}}
+	}
+
+	t.Run("within budget", func(t *testing.T) {
+		records := []*neo4j.Record{person("short", 2)}
+		got, err := format.FormatWithinBudget(compact, nil, records, 1000)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want, _ := compact.Format(nil, records)
+		if got.Text != want || len(got.Elisions) != 0 || got.OmittedRecords != 0 {
+			t.Errorf("expected the records unchanged, got %+v", got)
+		}
+	})
+
+	t.Run("no budget", func(t *testing.T) {
+		got, err := format.FormatWithinBudget(compact, nil, []*neo4j.Record{person(strings.Repeat("a", 5000), 100)}, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got.Elisions) != 0 || got.Tokens < 1000 {
+			t.Errorf("expected the records unchanged, got %d tokens and elisions %v", got.Tokens, got.Elisions)
+		}
+	})
+
+	t.Run("previews strings and summarizes lists", func(t *testing.T) {
+		records := []*neo4j.Record{person(strings.Repeat("a", 1500), 60), person("short", 60)}
+		got, err := format.FormatWithinBudget(compact, nil, records, 500)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Tokens > 500 || got.OmittedRecords != 0 {
+			t.Errorf("expected every record within 500 tokens, got %d tokens and %d omitted records", got.Tokens, got.OmittedRecords)
+		}
+		want := []format.Elision{
+			{Path: "n.bio", Action: format.ElisionPreviewed, Count: 1},
+			{Path: "tags", Action: format.ElisionSummarized, Count: 2},
+		}
+		if !reflect.DeepEqual(got.Elisions, want) {
+			t.Errorf("expected elisions %+v, got %+v", want, got.Elisions)
+		}
+		if !strings.Contains(got.Text, "... [500 more chars]") || !strings.Contains(got.Text, `"[... 10 more items]"`) {
+			t.Errorf("expected a preview and a list summary, got %s", got.Text)
+		}
+	})
+
+	t.Run("drops large property values", func(t *testing.T) {
+		large := make([]any, 10)
+		for i := range large {
+			large[i] = strings.Repeat("b", 60)
+		}
+		node := neo4j.Node{ElementId: "4:db:1", Props: map[string]any{"name": "Alice", "embedding": large}}
+		records := []*neo4j.Record{{Keys: []string{"n"}, Values: []any{node}}}
+
+		got, err := format.FormatWithinBudget(compact, nil, records, 100)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []format.Elision{{Path: "n.embedding", Action: format.ElisionDropped, Count: 1}}
+		if !reflect.DeepEqual(got.Elisions, want) {
+			t.Errorf("expected elisions %+v, got %+v", want, got.Elisions)
+		}
+		if strings.Contains(got.Text, "embedding") || !strings.Contains(got.Text, "Alice") {
+			t.Errorf("expected the embedding to be dropped, got %s", got.Text)
+		}
+	})
+
+	t.Run("leaves out trailing records", func(t *testing.T) {
+		records := make([]*neo4j.Record, 20)
+		for i := range records {
+			records[i] = person(strings.Repeat("a", 100), 20)
+		}
+		got, err := format.FormatWithinBudget(compact, nil, records, 200)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Tokens > 200 || got.OmittedRecords == 0 {
+			t.Fatalf("expected records left out to fit 200 tokens, got %d tokens and %d omitted records", got.Tokens, got.OmittedRecords)
+		}
+		returned := len(records) - got.OmittedRecords
+		want := []format.Elision{
+			{Path: "n.bio", Action: format.ElisionPreviewed, Count: returned},
+			{Path: "tags", Action: format.ElisionSummarized, Count: returned},
+		}
+		if !reflect.DeepEqual(got.Elisions, want) {
+			t.Errorf("expected the elisions of the %d records returned %+v, got %+v", returned, want, got.Elisions)
+		}
+	})
+
+	t.Run("elides within paths and maps", func(t *testing.T) {
+		alice := neo4j.Node{ElementId: "4:db:1", Props: map[string]any{"bio": strings.Repeat("a", 1200)}}
+		bob := neo4j.Node{ElementId: "4:db:2", Props: map[string]any{"bio": strings.Repeat("b", 1200)}}
+		knows := neo4j.Relationship{ElementId: "5:db:1", Type: "KNOWS", StartElementId: "4:db:1", EndElementId: "4:db:2"}
+		path := neo4j.Path{Nodes: []neo4j.Node{alice, bob}, Relationships: []neo4j.Relationship{knows}}
+		meta := map[string]any{"notes": []any{strings.Repeat("c", 1200)}}
+		records := []*neo4j.Record{{Keys: []string{"p", "meta"}, Values: []any{path, meta}}}
+
+		got, err := format.FormatWithinBudget(compact, nil, records, 600)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []format.Elision{
+			{Path: "meta.notes[]", Action: format.ElisionPreviewed, Count: 1},
+			{Path: "p.nodes[].bio", Action: format.ElisionPreviewed, Count: 2},
+		}
+		if !reflect.DeepEqual(got.Elisions, want) {
+			t.Errorf("expected elisions %+v, got %+v", want, got.Elisions)
+		}
+	})
+}
@@ -0,0 +1,104 @@
+// Package format formats the records of query results as the text returned to MCP clients.
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// RecordFormatter formats the records of a query result
+type RecordFormatter interface {
+	// Format returns records as text, keys being the columns of the result
+	Format(keys []string, records []*neo4j.Record) (string, error)
+	// RecordSize estimates the size of a record once formatted, to enforce the maximum response size
+	RecordSize(record *neo4j.Record) int
+}
+
+var (
+	mu         sync.RWMutex
+	formatters = map[string]RecordFormatter{
+		config.ResultFormatJSON:        jsonFormatter{},
+		config.ResultFormatCompactJSON: compactJSONFormatter{},
+		config.ResultFormatCSV:         csvFormatter{},
+		config.ResultFormatMarkdown:    markdownFormatter{},
+	}
+)
+
+// Register makes formatter selectable by name, replacing the formatter previously registered with that name
+func Register(name string, formatter RecordFormatter) {
+	mu.Lock()
+	defer mu.Unlock()
+	formatters[name] = formatter
+}
+
+// Get returns the formatter registered with the given name, the json formatter when name is empty
+func Get(name string) (RecordFormatter, error) {
+	if name == "" {
+		name = config.ResultFormatJSON
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	formatter, ok := formatters[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown format %q, must be one of %s", name, strings.Join(names(), ", "))
+	}
+	return formatter, nil
+}
+
+// Names returns the names of the registered formatters, sorted
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return names()
+}
+
+func names() []string {
+	list := make([]string, 0, len(formatters))
+	for name := range formatters {
+		list = append(list, name)
+	}
+	slices.Sort(list)
+	return list
+}
+
+// columns returns the columns of a result, taken from its first record when keys is empty
+func columns(keys []string, records []*neo4j.Record) []string {
+	if len(keys) == 0 && len(records) > 0 {
+		return records[0].Keys
+	}
+	return keys
+}
+
+// row returns the values of record in the order of columns, converted by database.SerializeValue
+func row(columns []string, record *neo4j.Record) []any {
+	values := make([]any, len(columns))
+	for i, column := range columns {
+		if value, ok := record.Get(column); ok {
+			values[i] = database.SerializeValue(value)
+		}
+	}
+	return values
+}
+
+// text returns a serialized value as a table cell: strings as is, null as an empty cell and other values as compact JSON
+func text(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		formatted, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(formatted), nil
+	}
+}
@@ -0,0 +1,185 @@
+package format_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neo4j/mcp/internal/format"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestFormat(t *testing.T) {
+	keys := []string{"name", "born", "tags", "note"}
+	records := []*neo4j.Record{
+		{Keys: keys, Values: []any{"Alice", neo4j.Date(time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC)), []any{"a", int64(1)}, nil}},
+		{Keys: keys, Values: []any{"Bob, Jr.", int64(1985), []any{}, "line 1\nline | 2"}},
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{
+			name:   "json",
+			format: "json",
+			want: `[
+  {
+    "born": "1990-01-02",
+    "name": "Alice",
+    "note": null,
+    "tags": [
+      "a",
+      1
+    ]
+  },
+  {
+    "born": 1985,
+    "name": "Bob, Jr.",
+    "note": "line 1\nline | 2",
+    "tags": []
+  }
+]`,
+		},
+		{
+			name:   "default",
+			format: "",
+			want: `[
+  {
+    "born": "1990-01-02",
+    "name": "Alice",
+    "note": null,
+    "tags": [
+      "a",
+      1
+    ]
+  },
+  {
+    "born": 1985,
+    "name": "Bob, Jr.",
+    "note": "line 1\nline | 2",
+    "tags": []
+  }
+]`,
+		},
+		{
+			name:   "compact json",
+			format: "json-compact",
+			want:   `{"columns":["name","born","tags","note"],"rows":[["Alice","1990-01-02",["a",1],null],["Bob, Jr.",1985,[],"line 1\nline | 2"]]}`,
+		},
+		{
+			name:   "csv",
+			format: "csv",
+			want:   "name,born,tags,note\nAlice,1990-01-02,\"[\"\"a\"\",1]\",\n\"Bob, Jr.\",1985,[],\"line 1\nline | 2\"\n",
+		},
+		{
+			name:   "markdown",
+			format: "markdown",
+			want: "| name | born | tags | note |\n" +
+				"| --- | --- | --- | --- |\n" +
+				"| Alice | 1990-01-02 | [\"a\",1] |  |\n" +
+				"| Bob, Jr. | 1985 | [] | line 1<br>line \\| 2 |",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formatter, err := format.Get(tt.format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := formatter.Format(keys, records)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatEmptyResult(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{format: "json", want: "[]"},
+		{format: "json-compact", want: `{"columns":["n"],"rows":[]}`},
+		{format: "csv", want: "n\n"},
+		{format: "markdown", want: "| n |\n| --- |"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			formatter, err := format.Get(tt.format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := formatter.Format([]string{"n"}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRecordSize(t *testing.T) {
+	record := &neo4j.Record{Keys: []string{"name", "age"}, Values: []any{"Alice", int64(30)}}
+
+	tests := []struct {
+		format string
+		want   int
+	}{
+		{format: "json", want: len("{\n    \"age\": 30,\n    \"name\": \"Alice\"\n  }") + 4},
+		{format: "json-compact", want: len(`["Alice",30]`) + 1},
+		{format: "csv", want: len("Alice,30\n")},
+		{format: "markdown", want: len("| Alice | 30 |\n")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			formatter, err := format.Get(tt.format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := formatter.RecordSize(record); got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+// upperFormatter is a formatter registered by a test
+type upperFormatter struct{}
+
+func (upperFormatter) Format([]string, []*neo4j.Record) (string, error) {
+	return "COLUMNS", nil
+}
+
+func (upperFormatter) RecordSize(*neo4j.Record) int {
+	return 1
+}
+
+func TestRegister(t *testing.T) {
+	if _, err := format.Get("xml"); err == nil || err.Error() != `unknown format "xml", must be one of csv, json, json-compact, markdown` {
+		t.Errorf("expected an error listing the formats, got: %v", err)
+	}
+
+	format.Register("upper", upperFormatter{})
+	formatter, err := format.Get("upper")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := formatter.Format(nil, nil); got != "COLUMNS" {
+		t.Errorf("expected the registered formatter, got %q", got)
+	}
+
+	names := format.Names()
+	if len(names) != 5 || names[4] != "upper" {
+		t.Errorf("expected the registered format among the names, got %v", names)
+	}
+}
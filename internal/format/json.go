@@ -0,0 +1,69 @@
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// jsonFormatter formats records as an indented JSON array of objects keyed by column
+type jsonFormatter struct{}
+
+func (jsonFormatter) Format(_ []string, records []*neo4j.Record) (string, error) {
+	results := make([]map[string]any, 0, len(records))
+	for _, record := range records {
+		results = append(results, database.SerializeRecord(record))
+	}
+
+	formatted, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format records as JSON: %w", err)
+	}
+	return string(formatted), nil
+}
+
+func (jsonFormatter) RecordSize(record *neo4j.Record) int {
+	formatted, err := json.MarshalIndent(database.SerializeRecord(record), "  ", "  ")
+	if err != nil {
+		return 0
+	}
+	// indentation and separator of the record within the array
+	return len(formatted) + 4
+}
+
+// compactJSONFormatter formats records as a JSON object holding the columns once and the values of each record as an array,
+// without indentation, e.g. {"columns":["name","age"],"rows":[["Alice",30],["Bob",25]]}
+type compactJSONFormatter struct{}
+
+// compactResult is the JSON object returned by compactJSONFormatter
+type compactResult struct {
+	Columns []string `json:"columns"`
+	Rows    [][]any  `json:"rows"`
+}
+
+func (compactJSONFormatter) Format(keys []string, records []*neo4j.Record) (string, error) {
+	result := compactResult{Columns: columns(keys, records), Rows: make([][]any, 0, len(records))}
+	if result.Columns == nil {
+		result.Columns = []string{}
+	}
+	for _, record := range records {
+		result.Rows = append(result.Rows, row(result.Columns, record))
+	}
+
+	formatted, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to format records as JSON: %w", err)
+	}
+	return string(formatted), nil
+}
+
+func (compactJSONFormatter) RecordSize(record *neo4j.Record) int {
+	formatted, err := json.Marshal(row(record.Keys, record))
+	if err != nil {
+		return 0
+	}
+	// separator of the row within the array
+	return len(formatted) + 1
+}
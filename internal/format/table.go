@@ -0,0 +1,114 @@
+package format
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// csvFormatter formats records as CSV, with a header line holding the columns.
+// Strings are written as is, null as an empty field and other values as compact JSON.
+type csvFormatter struct{}
+
+func (csvFormatter) Format(keys []string, records []*neo4j.Record) (string, error) {
+	columns := columns(keys, records)
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	if err := w.Write(columns); err != nil {
+		return "", fmt.Errorf("failed to format records as CSV: %w", err)
+	}
+	for _, record := range records {
+		fields, err := cells(columns, record)
+		if err != nil {
+			return "", fmt.Errorf("failed to format records as CSV: %w", err)
+		}
+		if err := w.Write(fields); err != nil {
+			return "", fmt.Errorf("failed to format records as CSV: %w", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("failed to format records as CSV: %w", err)
+	}
+	return b.String(), nil
+}
+
+func (csvFormatter) RecordSize(record *neo4j.Record) int {
+	fields, err := cells(record.Keys, record)
+	if err != nil {
+		return 0
+	}
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	_ = w.Write(fields)
+	w.Flush()
+	return b.Len()
+}
+
+// markdownFormatter formats records as a Markdown table, with a header row holding the columns.
+// Strings are written as is, null as an empty cell and other values as compact JSON;
+// pipes are escaped and line breaks replaced with <br> so each record stays on one row.
+type markdownFormatter struct{}
+
+func (markdownFormatter) Format(keys []string, records []*neo4j.Record) (string, error) {
+	columns := columns(keys, records)
+	if len(columns) == 0 {
+		return "", nil
+	}
+
+	var b strings.Builder
+	writeMarkdownRow(&b, columns)
+	separator := make([]string, len(columns))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	writeMarkdownRow(&b, separator)
+	for _, record := range records {
+		fields, err := cells(columns, record)
+		if err != nil {
+			return "", fmt.Errorf("failed to format records as Markdown: %w", err)
+		}
+		writeMarkdownRow(&b, fields)
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+func (markdownFormatter) RecordSize(record *neo4j.Record) int {
+	fields, err := cells(record.Keys, record)
+	if err != nil {
+		return 0
+	}
+	var b strings.Builder
+	writeMarkdownRow(&b, fields)
+	return b.Len()
+}
+
+// markdownEscaper escapes the characters breaking a Markdown table cell
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>", "\r", "<br>")
+
+func writeMarkdownRow(b *strings.Builder, fields []string) {
+	b.WriteString("|")
+	for _, field := range fields {
+		b.WriteString(" ")
+		b.WriteString(markdownEscaper.Replace(field))
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+}
+
+// cells returns the values of record in the order of columns, as table cells
+func cells(columns []string, record *neo4j.Record) ([]string, error) {
+	values := row(columns, record)
+	fields := make([]string, len(values))
+	for i, value := range values {
+		field, err := text(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = field
+	}
+	return fields, nil
+}
@@ -0,0 +1,66 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+const (
+	// labelArgument is the argument of the community-detection prompt restricting the nodes analyzed
+	labelArgument = "label"
+	// relationshipTypeArgument is the argument of the community-detection prompt restricting the relationships analyzed
+	relationshipTypeArgument = "relationshipType"
+)
+
+// CommunityDetectionSpec returns the prompt asking to run a GDS community detection algorithm
+func CommunityDetectionSpec() mcp.Prompt {
+	return mcp.NewPrompt("community-detection",
+		mcp.WithPromptDescription("Find communities of densely connected nodes with Neo4j Graph Data Science and store them on the nodes."),
+		mcp.WithArgument(labelArgument,
+			mcp.ArgumentDescription("Label of the nodes to analyze, all nodes when omitted."),
+		),
+		mcp.WithArgument(relationshipTypeArgument,
+			mcp.ArgumentDescription("Type of the relationships connecting them, all relationships when omitted."),
+		),
+		mcp.WithArgument(databaseArgument,
+			mcp.ArgumentDescription("Database to analyze, the default database when omitted."),
+		),
+	)
+}
+
+// CommunityDetectionHandler returns a handler for the community-detection prompt, pre-loading the schema
+func CommunityDetectionHandler(deps *tools.ToolDependencies) func(context.Context, mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		graphSchema, err := schemaText(ctx, deps, request)
+		if err != nil {
+			return nil, err
+		}
+		nodes, relationships := "all nodes", "all relationships"
+		if label := request.Params.Arguments[labelArgument]; label != "" {
+			nodes = fmt.Sprintf("the :%s nodes", label)
+		}
+		if relationshipType := request.Params.Arguments[relationshipTypeArgument]; relationshipType != "" {
+			relationships = fmt.Sprintf("the :%s relationships", relationshipType)
+		}
+		text := fmt.Sprintf(`Run a community detection on %s connected by %s, using Neo4j Graph Data Science.
+
+The schema of the database is:
+
+%s
+
+1. Use list-gds-procedures to check that GDS is installed and to pick the algorithm: Louvain or Leiden by default,
+   weakly connected components to find disconnected parts, label propagation for very large graphs.
+   Tell me which algorithm you picked and why.
+2. Project the graph with gds.graph.project under a unique name, as an undirected graph unless direction matters.
+3. Estimate the memory needed with the estimate mode of the algorithm before running it.
+4. Run the algorithm in stream mode with read-cypher to report the number of communities, their sizes
+   and a few representative members of the largest ones.
+5. Ask me before writing the community IDs back to the nodes with the write mode of the algorithm, using write-cypher.
+6. Drop the projected graph with gds.graph.drop when done.
+%s`, nodes, relationships, graphSchema, databaseInstruction(request))
+		return userPrompt("Run a community detection", text), nil
+	}
+}
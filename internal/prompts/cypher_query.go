@@ -0,0 +1,54 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+// questionArgument is the argument of the cypher-query prompt holding the question to answer
+const questionArgument = "question"
+
+// CypherQuerySpec returns the prompt asking for a Cypher query answering a question
+func CypherQuerySpec() mcp.Prompt {
+	return mcp.NewPrompt("cypher-query",
+		mcp.WithPromptDescription("Write and run a Cypher query answering a question about the data, based on the schema of the graph."),
+		mcp.WithArgument(questionArgument,
+			mcp.ArgumentDescription("Question to answer, in natural language."),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument(databaseArgument,
+			mcp.ArgumentDescription("Database to query, the default database when omitted."),
+		),
+	)
+}
+
+// CypherQueryHandler returns a handler for the cypher-query prompt, pre-loading the schema
+func CypherQueryHandler(deps *tools.ToolDependencies) func(context.Context, mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		question := request.Params.Arguments[questionArgument]
+		if question == "" {
+			return nil, fmt.Errorf("%s argument is required", questionArgument)
+		}
+		graphSchema, err := schemaText(ctx, deps, request)
+		if err != nil {
+			return nil, err
+		}
+		text := fmt.Sprintf(`Write a Cypher query answering this question: %s
+
+The schema of the database is:
+
+%s
+
+- Only use the labels, relationship types and properties of the schema, with the exact same spelling and direction.
+- Use parameters for the values taken from the question rather than literals.
+- Return only the properties needed to answer, not whole nodes, and always use LIMIT unless aggregating.
+- Run the query with read-cypher. If it fails or returns nothing, check the schema and the values with smaller queries,
+  fix the query and run it again.
+%s
+Answer the question from the results, then show the final query.`, question, graphSchema, databaseInstruction(request))
+		return userPrompt("Write a Cypher query answering a question", text), nil
+	}
+}
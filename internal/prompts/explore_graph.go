@@ -0,0 +1,43 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+// ExploreGraphSpec returns the prompt asking for an overview of the graph
+func ExploreGraphSpec() mcp.Prompt {
+	return mcp.NewPrompt("explore-graph",
+		mcp.WithPromptDescription("Explore the graph: describe what the data is about, how it is connected and which questions it can answer."),
+		mcp.WithArgument(databaseArgument,
+			mcp.ArgumentDescription("Database to explore, the default database when omitted."),
+		),
+	)
+}
+
+// ExploreGraphHandler returns a handler for the explore-graph prompt, pre-loading the schema
+func ExploreGraphHandler(deps *tools.ToolDependencies) func(context.Context, mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		graphSchema, err := schemaText(ctx, deps, request)
+		if err != nil {
+			return nil, err
+		}
+		text := fmt.Sprintf(`Explore this Neo4j graph and give me an overview of it.
+
+The schema of the database is:
+
+%s
+
+1. Describe the domain the graph models, its main entities and how they relate to each other.
+2. Use read-cypher to look at a few sample nodes and relationships of the most important labels and relationship types,
+   and to count how the data is distributed (e.g. degree of the main nodes, most frequent property values). Always use LIMIT.
+3. Point out anything surprising: disconnected labels, sparse properties, missing indexes on properties used to look nodes up.
+4. Suggest five questions this graph can answer, each with the Cypher query answering it.
+
+%sDo not modify the database.`, graphSchema, databaseInstruction(request))
+		return userPrompt("Explore the graph", text), nil
+	}
+}
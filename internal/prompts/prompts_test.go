@@ -0,0 +1,107 @@
+package prompts_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/config"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/prompts"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPromptHandlers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Database: "neo4j", AllowedDatabases: []string{"movies"}}
+	apocValue := map[string]any{
+		"Person": map[string]any{"type": "node", "count": int64(2)},
+	}
+
+	tests := []struct {
+		name      string
+		handler   func(*tools.ToolDependencies) func(context.Context, mcp.GetPromptRequest) (*mcp.GetPromptResult, error)
+		arguments map[string]string
+		reads     bool     // whether the schema is read from the database
+		want      []string // texts expected in the message, none when the prompt must fail
+	}{
+		{
+			name:    "explore-graph",
+			handler: prompts.ExploreGraphHandler,
+			reads:   true,
+			want:    []string{"(:Person) 2 nodes", "read-cypher"},
+		},
+		{
+			name:      "explore-graph of another database",
+			handler:   prompts.ExploreGraphHandler,
+			arguments: map[string]string{"database": "movies"},
+			reads:     true,
+			want:      []string{"(:Person) 2 nodes", `Pass database "movies" to every tool you call.`},
+		},
+		{
+			name:      "explore-graph of a database not allowed",
+			handler:   prompts.ExploreGraphHandler,
+			arguments: map[string]string{"database": "payroll"},
+		},
+		{
+			name:      "cypher-query",
+			handler:   prompts.CypherQueryHandler,
+			arguments: map[string]string{"question": "Who acted in The Matrix?"},
+			reads:     true,
+			want:      []string{"Who acted in The Matrix?", "(:Person) 2 nodes"},
+		},
+		{
+			name:    "cypher-query without question",
+			handler: prompts.CypherQueryHandler,
+		},
+		{
+			name:      "community-detection",
+			handler:   prompts.CommunityDetectionHandler,
+			arguments: map[string]string{"label": "Person", "relationshipType": "KNOWS"},
+			reads:     true,
+			want:      []string{"the :Person nodes connected by the :KNOWS relationships", "list-gds-procedures", "gds.graph.drop"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			if tt.reads {
+				mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]*neo4j.Record{{Keys: []string{"value"}, Values: []any{apocValue}}}, nil)
+				mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]*neo4j.Record{}, nil).Times(2)
+			}
+			deps := &tools.ToolDependencies{DBService: mockDB, Config: cfg}
+
+			request := mcp.GetPromptRequest{Params: mcp.GetPromptParams{Name: tt.name, Arguments: tt.arguments}}
+			result, err := tt.handler(deps)(context.Background(), request)
+			if len(tt.want) == 0 {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(result.Messages) != 1 || result.Messages[0].Role != mcp.RoleUser {
+				t.Fatalf("expected a single user message, got %+v", result.Messages)
+			}
+			text, ok := result.Messages[0].Content.(mcp.TextContent)
+			if !ok {
+				t.Fatalf("expected text content, got %T", result.Messages[0].Content)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(text.Text, want) {
+					t.Errorf("expected the message to contain %q, got:\n%s", want, text.Text)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Package prompts provides the MCP prompts guiding clients through common Neo4j workflows.
+package prompts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/schema"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+// databaseArgument is the optional argument of every prompt naming the database to work on
+const databaseArgument = "database"
+
+// schemaText returns the text rendering of the schema of the database named by the prompt arguments,
+// the configured default database when there is none
+func schemaText(ctx context.Context, deps *tools.ToolDependencies, request mcp.GetPromptRequest) (string, error) {
+	if deps.DBService == nil {
+		return "", fmt.Errorf("database service is not initialized")
+	}
+	ctx, err := tools.DatabaseContext(ctx, deps.Config, request.Params.Arguments[databaseArgument])
+	if err != nil {
+		return "", err
+	}
+	graphSchema, err := tools.ReadSchema(ctx, deps.DBService, deps.Config, deps.SchemaCache, schema.Options{}, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve schema: %w", err)
+	}
+	if graphSchema.IsEmpty() {
+		return "The database contains no data yet.", nil
+	}
+	return graphSchema.Text(), nil
+}
+
+// databaseInstruction tells which database the tools must target, when the prompt arguments name one
+func databaseInstruction(request mcp.GetPromptRequest) string {
+	if name := request.Params.Arguments[databaseArgument]; name != "" {
+		return fmt.Sprintf("Pass database %q to every tool you call.\n", name)
+	}
+	return ""
+}
+
+// userPrompt returns a prompt result made of a single user message
+func userPrompt(description, text string) *mcp.GetPromptResult {
+	return mcp.NewGetPromptResult(description, []mcp.PromptMessage{
+		mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text)),
+	})
+}
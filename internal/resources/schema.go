@@ -0,0 +1,132 @@
+// Package resources provides the MCP resources exposing the graph schema of the Neo4j databases.
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/schema"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+const (
+	// uriScheme is the scheme of the URIs of the resources
+	uriScheme = "neo4j"
+
+	// SchemaURITemplate is the URI template of the schema of a database
+	SchemaURITemplate = "neo4j://{database}/schema"
+	// LabelURITemplate is the URI template of the part of the schema of a database describing a label
+	LabelURITemplate = "neo4j://{database}/labels/{label}"
+
+	mimeTypeJSON = "application/json"
+)
+
+// SchemaURI returns the URI of the schema of the named database
+func SchemaURI(database string) string {
+	return (&url.URL{Scheme: uriScheme, Host: database, Path: "/schema"}).String()
+}
+
+// LabelURI returns the URI of the part of the schema of the named database describing a label
+func LabelURI(database, label string) string {
+	return (&url.URL{Scheme: uriScheme, Host: database, Path: "/labels/" + label}).String()
+}
+
+// SchemaSpec returns the resource of the schema of the named database, the configured default one
+func SchemaSpec(database string) mcp.Resource {
+	return mcp.NewResource(SchemaURI(database), fmt.Sprintf("Schema of the %s database", database),
+		mcp.WithResourceDescription("Labels, relationship types, properties, indexes and constraints of the database, as returned by the get-schema tool."),
+		mcp.WithMIMEType(mimeTypeJSON),
+	)
+}
+
+// SchemaTemplateSpec returns the resource template of the schema of any database the server may access
+func SchemaTemplateSpec() mcp.ResourceTemplate {
+	return mcp.NewResourceTemplate(SchemaURITemplate, "Database schema",
+		mcp.WithTemplateDescription("Labels, relationship types, properties, indexes and constraints of a database, as returned by the get-schema tool. Use list-databases to find the available databases."),
+		mcp.WithTemplateMIMEType(mimeTypeJSON),
+	)
+}
+
+// LabelTemplateSpec returns the resource template of the part of the schema describing a label
+func LabelTemplateSpec() mcp.ResourceTemplate {
+	return mcp.NewResourceTemplate(LabelURITemplate, "Label schema",
+		mcp.WithTemplateDescription("Properties of the nodes with a label, the relationship types connecting them and the indexes and constraints applying to them."),
+		mcp.WithTemplateMIMEType(mimeTypeJSON),
+	)
+}
+
+// SchemaHandler returns a handler reading the schema resources
+func SchemaHandler(deps *tools.ToolDependencies) func(context.Context, mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		ctx, err := resourceContext(ctx, deps, request.Params.URI)
+		if err != nil {
+			return nil, err
+		}
+		graphSchema, err := tools.ReadSchema(ctx, deps.DBService, deps.Config, deps.SchemaCache, schema.Options{}, false)
+		if err != nil {
+			return nil, err
+		}
+		return jsonContents(request.Params.URI, graphSchema)
+	}
+}
+
+// LabelHandler returns a handler reading the label resources
+func LabelHandler(deps *tools.ToolDependencies) func(context.Context, mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		ctx, err := resourceContext(ctx, deps, request.Params.URI)
+		if err != nil {
+			return nil, err
+		}
+		label, ok := strings.CutPrefix(uriPath(request.Params.URI), "/labels/")
+		if !ok || label == "" {
+			return nil, fmt.Errorf("invalid label resource URI %q", request.Params.URI)
+		}
+		graphSchema, err := tools.ReadSchema(ctx, deps.DBService, deps.Config, deps.SchemaCache, schema.Options{}, false)
+		if err != nil {
+			return nil, err
+		}
+		labelSchema, ok := graphSchema.LabelSchema(label)
+		if !ok {
+			return nil, fmt.Errorf("label %q not found in the schema", label)
+		}
+		return jsonContents(request.Params.URI, labelSchema)
+	}
+}
+
+// resourceContext returns a copy of ctx targeting the database of a resource URI, when it is allowed by the configuration
+func resourceContext(ctx context.Context, deps *tools.ToolDependencies, uri string) (context.Context, error) {
+	if deps.DBService == nil {
+		return nil, fmt.Errorf("database service is not initialized")
+	}
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != uriScheme || u.Host == "" {
+		return nil, fmt.Errorf("invalid resource URI %q", uri)
+	}
+	if deps.Config != nil && strings.EqualFold(u.Host, deps.Config.Database) {
+		return ctx, nil
+	}
+	return tools.DatabaseContext(ctx, deps.Config, u.Host)
+}
+
+// uriPath returns the unescaped path of a resource URI
+func uriPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return ""
+	}
+	return u.Path
+}
+
+func jsonContents(uri string, v any) ([]mcp.ResourceContents, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to format resource: %w", err)
+	}
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{URI: uri, MIMEType: mimeTypeJSON, Text: string(data)},
+	}, nil
+}
@@ -0,0 +1,109 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/resources"
+	"github.com/neo4j/mcp/internal/schema"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+// targetsDatabase matches contexts targeting the named database, or no database when name is empty
+func targetsDatabase(name string) gomock.Matcher {
+	return gomock.Cond(func(ctx context.Context) bool {
+		got, ok := database.DatabaseFromContext(ctx)
+		return got == name && ok == (name != "")
+	})
+}
+
+func TestURIs(t *testing.T) {
+	if got := resources.SchemaURI("movies"); got != "neo4j://movies/schema" {
+		t.Errorf("SchemaURI() = %q, want neo4j://movies/schema", got)
+	}
+	if got := resources.LabelURI("movies", "Person"); got != "neo4j://movies/labels/Person" {
+		t.Errorf("LabelURI() = %q, want neo4j://movies/labels/Person", got)
+	}
+}
+
+func TestSchemaHandlers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Database: "neo4j", AllowedDatabases: []string{"movies"}}
+	apocValue := map[string]any{
+		"Person": map[string]any{
+			"type":  "node",
+			"count": int64(2),
+			"properties": map[string]any{
+				"name": map[string]any{"type": "STRING"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		handler   func(*tools.ToolDependencies) func(context.Context, mcp.ReadResourceRequest) ([]mcp.ResourceContents, error)
+		uri       string
+		database  string // database the schema is read from, empty for the default one
+		reads     bool   // whether the schema is read from the database
+		wantLabel string // label expected in the contents, empty when reading must fail
+	}{
+		{name: "schema of the default database", handler: resources.SchemaHandler, uri: "neo4j://neo4j/schema", reads: true, wantLabel: "Person"},
+		{name: "schema of an allowed database", handler: resources.SchemaHandler, uri: "neo4j://movies/schema", database: "movies", reads: true, wantLabel: "Person"},
+		{name: "schema of a database not allowed", handler: resources.SchemaHandler, uri: "neo4j://payroll/schema"},
+		{name: "invalid URI", handler: resources.SchemaHandler, uri: "bolt://neo4j/schema"},
+		{name: "label", handler: resources.LabelHandler, uri: "neo4j://neo4j/labels/Person", reads: true, wantLabel: "Person"},
+		{name: "unknown label", handler: resources.LabelHandler, uri: "neo4j://neo4j/labels/Movie", reads: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			if tt.reads {
+				mockDB.EXPECT().ExecuteReadQuery(targetsDatabase(tt.database), gomock.Any(), gomock.Any()).
+					Return([]*neo4j.Record{{Keys: []string{"value"}, Values: []any{apocValue}}}, nil)
+				mockDB.EXPECT().ExecuteReadQuery(targetsDatabase(tt.database), gomock.Any(), gomock.Any()).
+					Return([]*neo4j.Record{}, nil).Times(2)
+			}
+			deps := &tools.ToolDependencies{DBService: mockDB, Config: cfg}
+
+			request := mcp.ReadResourceRequest{Params: mcp.ReadResourceParams{URI: tt.uri}}
+			contents, err := tt.handler(deps)(context.Background(), request)
+			if tt.wantLabel == "" {
+				if err == nil {
+					t.Errorf("expected an error, got contents %+v", contents)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(contents) != 1 {
+				t.Fatalf("expected 1 content, got %d", len(contents))
+			}
+			text, ok := contents[0].(mcp.TextResourceContents)
+			if !ok || text.URI != tt.uri || text.MIMEType != "application/json" {
+				t.Fatalf("expected JSON text contents of %s, got %+v", tt.uri, contents[0])
+			}
+
+			var got struct {
+				Name   string         `json:"name"`
+				Labels []schema.Label `json:"labels"`
+			}
+			if err := json.Unmarshal([]byte(text.Text), &got); err != nil {
+				t.Fatalf("failed to parse contents: %v", err)
+			}
+			if got.Name != tt.wantLabel && (len(got.Labels) != 1 || got.Labels[0].Name != tt.wantLabel) {
+				t.Errorf("expected the schema of label %s, got %s", tt.wantLabel, text.Text)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package schema
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/neo4j/mcp/internal/database"
+)
+
+// apocSchemaQuery retrieves the labels, relationship types and properties of the graph
+const apocSchemaQuery = "CALL apoc.meta.schema($config) YIELD value RETURN value"
+
+// introspectAPOC builds the labels and relationship types of a schema with apoc.meta.schema()
+func introspectAPOC(ctx context.Context, executor database.QueryExecutor, opts Options) (*Schema, error) {
+	records, err := executor.ExecuteReadQuery(ctx, apocSchemaQuery, map[string]any{"config": opts.apocConfig()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the graph schema: %w", err)
+	}
+	value := make(map[string]any)
+	if len(records) > 0 {
+		if v, ok := records[0].Get("value"); ok {
+			value, _ = v.(map[string]any)
+		}
+	}
+	return fromAPOC(value), nil
+}
+
+// fromAPOC builds the labels and relationship types of a schema from the map returned by apoc.meta.schema()
+func fromAPOC(value map[string]any) *Schema {
+	s := &Schema{
+		Labels:            make([]Label, 0),
+		RelationshipTypes: make([]RelationshipType, 0),
+		Indexes:           make([]Index, 0),
+		Constraints:       make([]Constraint, 0),
+	}
+	// APOC only lists the end labels of the outgoing relationships of each label
+	patterns := make(map[string][]Pattern)
+
+	for name, raw := range value {
+		entry, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch entry["type"] {
+		case "node":
+			s.Labels = append(s.Labels, Label{
+				Name:       name,
+				Count:      int64Value(entry["count"]),
+				Properties: apocProperties(entry["properties"]),
+			})
+			relationships, _ := entry["relationships"].(map[string]any)
+			for relType, rawRel := range relationships {
+				rel, ok := rawRel.(map[string]any)
+				if !ok || rel["direction"] != "out" {
+					continue
+				}
+				for _, end := range stringList(rel["labels"]) {
+					patterns[relType] = append(patterns[relType], Pattern{Start: name, End: end})
+				}
+			}
+		case "relationship":
+			s.RelationshipTypes = append(s.RelationshipTypes, RelationshipType{
+				Type:       name,
+				Count:      int64Value(entry["count"]),
+				Properties: apocProperties(entry["properties"]),
+			})
+		}
+	}
+
+	for i := range s.RelationshipTypes {
+		relPatterns := patterns[s.RelationshipTypes[i].Type]
+		slices.SortFunc(relPatterns, func(a, b Pattern) int {
+			return cmp.Or(cmp.Compare(a.Start, b.Start), cmp.Compare(a.End, b.End))
+		})
+		s.RelationshipTypes[i].Patterns = append(make([]Pattern, 0, len(relPatterns)), relPatterns...)
+	}
+	slices.SortFunc(s.Labels, func(a, b Label) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(s.RelationshipTypes, func(a, b RelationshipType) int { return cmp.Compare(a.Type, b.Type) })
+	return s
+}
+
+// apocProperties converts the properties map of an apoc.meta.schema() entry, sorted by name
+func apocProperties(raw any) []Property {
+	entries, _ := raw.(map[string]any)
+	properties := make([]Property, 0, len(entries))
+	for name, rawProperty := range entries {
+		property, _ := rawProperty.(map[string]any)
+		// APOC lists the relationships of a label among its properties
+		if property["type"] == "RELATIONSHIP" {
+			continue
+		}
+		properties = append(properties, Property{
+			Name:     name,
+			Type:     stringValue(property["type"]),
+			Indexed:  property["indexed"] == true,
+			Unique:   property["unique"] == true,
+			Required: property["existence"] == true,
+		})
+	}
+	slices.SortFunc(properties, func(a, b Property) int { return cmp.Compare(a.Name, b.Name) })
+	return properties
+}
+
+func stringValue(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+func int64Value(v any) int64 {
+	switch n := v.(type) {
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	case float64:
+		return int64(n)
+	}
+	return 0
+}
+
+func stringList(v any) []string {
+	items, _ := v.([]any)
+	list := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			list = append(list, s)
+		}
+	}
+	return list
+}
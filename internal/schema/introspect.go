@@ -0,0 +1,151 @@
+package schema
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// procedureNotFoundCode is the code of the error raised when calling a procedure that is not installed
+const procedureNotFoundCode = "Neo.ClientError.Procedure.ProcedureNotFound"
+
+const (
+	// indexesQuery retrieves the indexes, the token lookup indexes every database has excluded
+	indexesQuery = `
+        SHOW INDEXES
+        YIELD name, type, entityType, labelsOrTypes, properties, state
+        WHERE type <> 'LOOKUP'
+        RETURN name, type, entityType, labelsOrTypes, properties, state
+    `
+
+	// constraintsQuery retrieves the constraints
+	constraintsQuery = `
+        SHOW CONSTRAINTS
+        YIELD name, type, entityType, labelsOrTypes, properties
+        RETURN name, type, entityType, labelsOrTypes, properties
+    `
+)
+
+// Introspect reads the schema of the database targeted by ctx with the given strategy, one of the config.SchemaStrategy constants,
+// scoped to the options.
+// The auto strategy uses APOC and falls back to the built-in db.schema procedures when APOC is not installed.
+// Indexes and constraints are best effort: they are left empty when the user is not allowed to list them.
+func Introspect(ctx context.Context, executor database.QueryExecutor, strategy string, opts Options) (*Schema, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	var s *Schema
+	var err error
+	switch strategy {
+	case config.SchemaStrategyAPOC:
+		s, err = introspectAPOC(ctx, executor, opts)
+	case config.SchemaStrategyNative:
+		s, err = introspectNative(ctx, executor, opts)
+	default:
+		s, err = introspectAPOC(ctx, executor, opts)
+		if isProcedureNotFound(err) {
+			log.Printf("APOC is not available, falling back to the db.schema procedures: %v", err)
+			s, err = introspectNative(ctx, executor, opts)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if records, err := executor.ExecuteReadQuery(ctx, indexesQuery, nil); err != nil {
+		log.Printf("Failed to list indexes, leaving them out of the schema: %v", err)
+	} else {
+		s.Indexes = indexes(records)
+	}
+	if records, err := executor.ExecuteReadQuery(ctx, constraintsQuery, nil); err != nil {
+		log.Printf("Failed to list constraints, leaving them out of the schema: %v", err)
+	} else {
+		s.Constraints = constraints(records)
+	}
+	s.annotateProperties()
+	s.scope(opts)
+	return s, nil
+}
+
+// isProcedureNotFound reports whether err was caused by calling a procedure that is not installed
+func isProcedureNotFound(err error) bool {
+	var neo4jErr *neo4j.Neo4jError
+	return errors.As(err, &neo4jErr) && neo4jErr.Code == procedureNotFoundCode
+}
+
+// annotateProperties flags the properties covered by the indexes and constraints of the schema,
+// which the db.schema procedures do not report
+func (s *Schema) annotateProperties() {
+	for i := range s.Labels {
+		annotate(s.Labels[i].Properties, "NODE", s.Labels[i].Name, s.Indexes, s.Constraints)
+	}
+	for i := range s.RelationshipTypes {
+		annotate(s.RelationshipTypes[i].Properties, "RELATIONSHIP", s.RelationshipTypes[i].Type, s.Indexes, s.Constraints)
+	}
+}
+
+func annotate(properties []Property, entityType, token string, indexes []Index, constraints []Constraint) {
+	for i := range properties {
+		p := &properties[i]
+		for _, index := range indexes {
+			if index.EntityType == entityType && slices.Contains(index.LabelsOrTypes, token) && slices.Contains(index.Properties, p.Name) {
+				p.Indexed = true
+			}
+		}
+		for _, constraint := range constraints {
+			if constraint.EntityType != entityType || !slices.Contains(constraint.LabelsOrTypes, token) || !slices.Contains(constraint.Properties, p.Name) {
+				continue
+			}
+			if strings.HasSuffix(constraint.Type, "KEY") || strings.HasSuffix(constraint.Type, "PROPERTY_EXISTENCE") {
+				p.Required = true
+			}
+			// a key or uniqueness constraint on several properties makes only their combination unique
+			if (strings.HasSuffix(constraint.Type, "KEY") || strings.HasSuffix(constraint.Type, "UNIQUENESS")) && len(constraint.Properties) == 1 {
+				p.Unique = true
+			}
+		}
+	}
+}
+
+// indexes converts the records of indexesQuery, sorted by name
+func indexes(records []*neo4j.Record) []Index {
+	list := make([]Index, 0, len(records))
+	for _, record := range records {
+		values := record.AsMap()
+		list = append(list, Index{
+			Name:          stringValue(values["name"]),
+			Type:          stringValue(values["type"]),
+			EntityType:    stringValue(values["entityType"]),
+			LabelsOrTypes: stringList(values["labelsOrTypes"]),
+			Properties:    stringList(values["properties"]),
+			State:         stringValue(values["state"]),
+		})
+	}
+	slices.SortFunc(list, func(a, b Index) int { return cmp.Compare(a.Name, b.Name) })
+	return list
+}
+
+// constraints converts the records of constraintsQuery, sorted by name
+func constraints(records []*neo4j.Record) []Constraint {
+	list := make([]Constraint, 0, len(records))
+	for _, record := range records {
+		values := record.AsMap()
+		list = append(list, Constraint{
+			Name:          stringValue(values["name"]),
+			Type:          stringValue(values["type"]),
+			EntityType:    stringValue(values["entityType"]),
+			LabelsOrTypes: stringList(values["labelsOrTypes"]),
+			Properties:    stringList(values["properties"]),
+		})
+	}
+	slices.SortFunc(list, func(a, b Constraint) int { return cmp.Compare(a.Name, b.Name) })
+	return list
+}
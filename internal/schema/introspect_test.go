@@ -0,0 +1,290 @@
+package schema_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/config"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/schema"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+// apocValue mirrors the output of apoc.meta.schema() for a small movies graph
+var apocValue = map[string]any{
+	"Person": map[string]any{
+		"type":  "node",
+		"count": int64(3),
+		"properties": map[string]any{
+			"name":     map[string]any{"type": "STRING", "indexed": true, "unique": true, "existence": true},
+			"born":     map[string]any{"type": "INTEGER"},
+			"ACTED_IN": map[string]any{"type": "RELATIONSHIP"},
+		},
+		"relationships": map[string]any{
+			"ACTED_IN": map[string]any{"direction": "out", "labels": []any{"Movie"}},
+		},
+	},
+	"Movie": map[string]any{
+		"type":  "node",
+		"count": int64(2),
+		"properties": map[string]any{
+			"title": map[string]any{"type": "STRING"},
+		},
+		"relationships": map[string]any{
+			"ACTED_IN": map[string]any{"direction": "in", "labels": []any{"Person"}},
+		},
+	},
+	"ACTED_IN": map[string]any{
+		"type":  "relationship",
+		"count": int64(4),
+		"properties": map[string]any{
+			"roles": map[string]any{"type": "LIST", "array": true},
+		},
+	},
+}
+
+func TestIntrospect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("labels, relationship types, indexes and constraints", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		gomock.InOrder(
+			mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), map[string]any{"config": map[string]any{}}).
+				Return([]*neo4j.Record{{Keys: []string{"value"}, Values: []any{apocValue}}}, nil),
+			mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+				Return([]*neo4j.Record{{
+					Keys:   []string{"name", "type", "entityType", "labelsOrTypes", "properties", "state"},
+					Values: []any{"person_name", "RANGE", "NODE", []any{"Person"}, []any{"name"}, "ONLINE"},
+				}}, nil),
+			mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+				Return([]*neo4j.Record{{
+					Keys:   []string{"name", "type", "entityType", "labelsOrTypes", "properties"},
+					Values: []any{"person_name", "UNIQUENESS", "NODE", []any{"Person"}, []any{"name"}},
+				}}, nil),
+		)
+
+		got, err := schema.Introspect(context.Background(), mockDB, config.SchemaStrategyAPOC, schema.Options{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		want := &schema.Schema{
+			Labels: []schema.Label{
+				{Name: "Movie", Count: 2, Properties: []schema.Property{{Name: "title", Type: "STRING"}}},
+				{Name: "Person", Count: 3, Properties: []schema.Property{
+					{Name: "born", Type: "INTEGER"},
+					{Name: "name", Type: "STRING", Indexed: true, Unique: true, Required: true},
+				}},
+			},
+			RelationshipTypes: []schema.RelationshipType{
+				{
+					Type:       "ACTED_IN",
+					Count:      4,
+					Properties: []schema.Property{{Name: "roles", Type: "LIST"}},
+					Patterns:   []schema.Pattern{{Start: "Person", End: "Movie"}},
+				},
+			},
+			Indexes: []schema.Index{
+				{Name: "person_name", Type: "RANGE", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"name"}, State: "ONLINE"},
+			},
+			Constraints: []schema.Constraint{
+				{Name: "person_name", Type: "UNIQUENESS", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"name"}},
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Introspect():\ngot  %+v\nwant %+v", got, want)
+		}
+	})
+
+	t.Run("empty database", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*neo4j.Record{}, nil).Times(3)
+
+		got, err := schema.Introspect(context.Background(), mockDB, config.SchemaStrategyAPOC, schema.Options{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if !got.IsEmpty() {
+			t.Errorf("Expected an empty schema, got: %+v", got)
+		}
+		if got.Labels == nil || got.RelationshipTypes == nil || got.Indexes == nil || got.Constraints == nil {
+			t.Errorf("Expected empty lists rather than nil, got: %+v", got)
+		}
+	})
+
+	t.Run("schema query failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("apoc not installed"))
+
+		if _, err := schema.Introspect(context.Background(), mockDB, config.SchemaStrategyAPOC, schema.Options{}); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
+
+func TestText(t *testing.T) {
+	s := &schema.Schema{
+		Labels: []schema.Label{
+			{Name: "Person", Count: 3, Properties: []schema.Property{{Name: "born", Type: "INTEGER"}, {Name: "name", Type: "STRING"}}},
+		},
+		RelationshipTypes: []schema.RelationshipType{
+			{Type: "ACTED_IN", Count: 4, Properties: []schema.Property{{Name: "roles", Type: "LIST"}}, Patterns: []schema.Pattern{{Start: "Person", End: "Movie"}}},
+			{Type: "KNOWS", Count: 1},
+		},
+		Indexes: []schema.Index{
+			{Name: "person_name", Type: "RANGE", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"name"}, State: "ONLINE"},
+			{Name: "knows_since", Type: "RANGE", EntityType: "RELATIONSHIP", LabelsOrTypes: []string{"KNOWS"}, Properties: []string{"since"}, State: "POPULATING"},
+		},
+		Constraints: []schema.Constraint{
+			{Name: "person_name", Type: "UNIQUENESS", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"name"}},
+		},
+	}
+
+	want := `Node labels:
+(:Person {born: INTEGER, name: STRING}) 3 nodes
+Relationship types:
+(:Person)-[:ACTED_IN {roles: LIST}]->(:Movie) 4 relationships
+()-[:KNOWS]->() 1 relationships
+Indexes:
+RANGE INDEX person_name FOR (:Person) ON (name)
+RANGE INDEX knows_since FOR ()-[:KNOWS]-() ON (since) POPULATING
+Constraints:
+UNIQUENESS CONSTRAINT person_name FOR (:Person) REQUIRE (name)`
+
+	if got := s.Text(); got != want {
+		t.Errorf("Text():\ngot\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestIntrospectNative(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	person := neo4j.Node{ElementId: "-1", Labels: []string{"Person"}}
+	movie := neo4j.Node{ElementId: "-2", Labels: []string{"Movie"}}
+	actedIn := neo4j.Relationship{ElementId: "-3", StartElementId: "-1", EndElementId: "-2", Type: "ACTED_IN"}
+
+	mockDB := db.NewMockService(ctrl)
+	gomock.InOrder(
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return([]*neo4j.Record{
+				{Keys: []string{"nodeLabels", "propertyName", "propertyTypes"}, Values: []any{[]any{"Person"}, "name", []any{"String"}}},
+				{Keys: []string{"nodeLabels", "propertyName", "propertyTypes"}, Values: []any{[]any{"Person"}, "born", []any{"Long"}}},
+				{Keys: []string{"nodeLabels", "propertyName", "propertyTypes"}, Values: []any{[]any{"Person", "Director"}, "born", []any{"Double"}}},
+				{Keys: []string{"nodeLabels", "propertyName", "propertyTypes"}, Values: []any{[]any{"Movie"}, nil, nil}},
+			}, nil),
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return([]*neo4j.Record{
+				{Keys: []string{"relType", "propertyName", "propertyTypes"}, Values: []any{":`ACTED_IN`", "roles", []any{"StringArray"}}},
+			}, nil),
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return([]*neo4j.Record{
+				{Keys: []string{"nodes", "relationships"}, Values: []any{[]any{person, movie}, []any{actedIn}}},
+			}, nil),
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "MATCH (:`Director`) RETURN $name0 AS name, count(*) AS count UNION ALL "+
+			"MATCH (:`Movie`) RETURN $name1 AS name, count(*) AS count UNION ALL "+
+			"MATCH (:`Person`) RETURN $name2 AS name, count(*) AS count",
+			map[string]any{"name0": "Director", "name1": "Movie", "name2": "Person"}).
+			Return([]*neo4j.Record{
+				{Keys: []string{"name", "count"}, Values: []any{"Director", int64(1)}},
+				{Keys: []string{"name", "count"}, Values: []any{"Movie", int64(2)}},
+				{Keys: []string{"name", "count"}, Values: []any{"Person", int64(3)}},
+			}, nil),
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "MATCH ()-[:`ACTED_IN`]->() RETURN $name0 AS name, count(*) AS count",
+			map[string]any{"name0": "ACTED_IN"}).
+			Return([]*neo4j.Record{{Keys: []string{"name", "count"}, Values: []any{"ACTED_IN", int64(4)}}}, nil),
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return([]*neo4j.Record{{
+				Keys:   []string{"name", "type", "entityType", "labelsOrTypes", "properties", "state"},
+				Values: []any{"person_name", "RANGE", "NODE", []any{"Person"}, []any{"name"}, "ONLINE"},
+			}}, nil),
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return([]*neo4j.Record{{
+				Keys:   []string{"name", "type", "entityType", "labelsOrTypes", "properties"},
+				Values: []any{"person_key", "NODE_KEY", "NODE", []any{"Person"}, []any{"name"}},
+			}}, nil),
+	)
+
+	got, err := schema.Introspect(context.Background(), mockDB, config.SchemaStrategyNative, schema.Options{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	wantLabels := []schema.Label{
+		{Name: "Director", Count: 1, Properties: []schema.Property{{Name: "born", Type: "FLOAT"}}},
+		{Name: "Movie", Count: 2, Properties: []schema.Property{}},
+		{Name: "Person", Count: 3, Properties: []schema.Property{
+			{Name: "born", Type: "FLOAT | INTEGER"},
+			{Name: "name", Type: "STRING", Indexed: true, Unique: true, Required: true},
+		}},
+	}
+	if !reflect.DeepEqual(got.Labels, wantLabels) {
+		t.Errorf("Labels:\ngot  %+v\nwant %+v", got.Labels, wantLabels)
+	}
+	wantRelationshipTypes := []schema.RelationshipType{
+		{
+			Type:       "ACTED_IN",
+			Count:      4,
+			Properties: []schema.Property{{Name: "roles", Type: "LIST"}},
+			Patterns:   []schema.Pattern{{Start: "Person", End: "Movie"}},
+		},
+	}
+	if !reflect.DeepEqual(got.RelationshipTypes, wantRelationshipTypes) {
+		t.Errorf("RelationshipTypes:\ngot  %+v\nwant %+v", got.RelationshipTypes, wantRelationshipTypes)
+	}
+}
+
+func TestIntrospectStrategy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	procedureNotFound := fmt.Errorf("failed to execute read query: %w", &neo4j.Neo4jError{
+		Code: "Neo.ClientError.Procedure.ProcedureNotFound",
+		Msg:  "There is no procedure with the name `apoc.meta.schema` registered for this database instance.",
+	})
+
+	isQuery := func(prefix string) gomock.Matcher {
+		return gomock.Cond(func(query string) bool { return strings.HasPrefix(strings.TrimSpace(query), prefix) })
+	}
+
+	tests := []struct {
+		name       string
+		strategy   string
+		apocErr    error
+		wantNative bool
+		wantErr    bool
+		skipAPOC   bool
+	}{
+		{name: "auto with APOC", strategy: config.SchemaStrategyAuto},
+		{name: "auto without APOC", strategy: config.SchemaStrategyAuto, apocErr: procedureNotFound, wantNative: true},
+		{name: "auto with another APOC error", strategy: config.SchemaStrategyAuto, apocErr: errors.New("connection refused"), wantErr: true},
+		{name: "apoc without APOC", strategy: config.SchemaStrategyAPOC, apocErr: procedureNotFound, wantErr: true},
+		{name: "native", strategy: config.SchemaStrategyNative, wantNative: true, skipAPOC: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			if !tt.skipAPOC {
+				mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), isQuery("CALL apoc.meta.schema"), gomock.Any()).Return([]*neo4j.Record{}, tt.apocErr)
+			}
+			if tt.wantNative {
+				mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), isQuery("CALL db.schema."), gomock.Nil()).Return([]*neo4j.Record{}, nil).Times(3)
+			}
+			if !tt.wantErr {
+				mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), isQuery("SHOW "), gomock.Nil()).Return([]*neo4j.Record{}, nil).Times(2)
+			}
+
+			_, err := schema.Introspect(context.Background(), mockDB, tt.strategy, schema.Options{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Introspect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package schema
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+const (
+	// nodeTypePropertiesQuery retrieves the properties of each label combination
+	nodeTypePropertiesQuery = `
+        CALL db.schema.nodeTypeProperties()
+        YIELD nodeLabels, propertyName, propertyTypes
+        RETURN nodeLabels, propertyName, propertyTypes
+    `
+
+	// relTypePropertiesQuery retrieves the properties of each relationship type
+	relTypePropertiesQuery = `
+        CALL db.schema.relTypeProperties()
+        YIELD relType, propertyName, propertyTypes
+        RETURN relType, propertyName, propertyTypes
+    `
+
+	// visualizationQuery retrieves the labels connected by each relationship type, as a virtual graph
+	visualizationQuery = "CALL db.schema.visualization() YIELD nodes, relationships RETURN nodes, relationships"
+)
+
+// nativeTypes maps the property types reported by the db.schema procedures to the Cypher types reported by APOC
+var nativeTypes = map[string]string{
+	"String":        "STRING",
+	"Long":          "INTEGER",
+	"Integer":       "INTEGER",
+	"Double":        "FLOAT",
+	"Float":         "FLOAT",
+	"Boolean":       "BOOLEAN",
+	"Date":          "DATE",
+	"DateTime":      "DATE_TIME",
+	"LocalDateTime": "LOCAL_DATE_TIME",
+	"Time":          "TIME",
+	"LocalTime":     "LOCAL_TIME",
+	"Duration":      "DURATION",
+	"Point":         "POINT",
+}
+
+// introspectNative builds the labels and relationship types of a schema with the built-in db.schema procedures.
+// The procedures cannot be scoped nor sampled, only the counts are restricted to the labels and relationship types kept by the options.
+func introspectNative(ctx context.Context, executor database.QueryExecutor, opts Options) (*Schema, error) {
+	nodeRecords, err := executor.ExecuteReadQuery(ctx, nodeTypePropertiesQuery, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the node properties: %w", err)
+	}
+	relRecords, err := executor.ExecuteReadQuery(ctx, relTypePropertiesQuery, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the relationship properties: %w", err)
+	}
+	vizRecords, err := executor.ExecuteReadQuery(ctx, visualizationQuery, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the relationship patterns: %w", err)
+	}
+
+	labelProperties := make(map[string]map[string][]string)
+	for _, record := range nodeRecords {
+		values := record.AsMap()
+		for _, label := range stringList(values["nodeLabels"]) {
+			if !opts.labelIncluded(label) {
+				continue
+			}
+			addProperty(labelProperties, label, values["propertyName"], values["propertyTypes"])
+		}
+	}
+	relProperties := make(map[string]map[string][]string)
+	for _, record := range relRecords {
+		values := record.AsMap()
+		relType := relTypeName(stringValue(values["relType"]))
+		if !opts.relationshipTypeIncluded(relType) {
+			continue
+		}
+		addProperty(relProperties, relType, values["propertyName"], values["propertyTypes"])
+	}
+
+	s := &Schema{
+		Labels:            make([]Label, 0, len(labelProperties)),
+		RelationshipTypes: make([]RelationshipType, 0, len(relProperties)),
+		Indexes:           make([]Index, 0),
+		Constraints:       make([]Constraint, 0),
+	}
+
+	labelCounts, err := counts(ctx, executor, "(:%s)", mapKeys(labelProperties))
+	if err != nil {
+		return nil, err
+	}
+	for name, properties := range labelProperties {
+		s.Labels = append(s.Labels, Label{Name: name, Count: labelCounts[name], Properties: nativeProperties(properties)})
+	}
+
+	relCounts, err := counts(ctx, executor, "()-[:%s]->()", mapKeys(relProperties))
+	if err != nil {
+		return nil, err
+	}
+	patterns := visualizationPatterns(vizRecords)
+	for name, properties := range relProperties {
+		relPatterns := append(make([]Pattern, 0), patterns[name]...)
+		slices.SortFunc(relPatterns, func(a, b Pattern) int {
+			return cmp.Or(cmp.Compare(a.Start, b.Start), cmp.Compare(a.End, b.End))
+		})
+		s.RelationshipTypes = append(s.RelationshipTypes, RelationshipType{
+			Type:       name,
+			Count:      relCounts[name],
+			Properties: nativeProperties(properties),
+			Patterns:   relPatterns,
+		})
+	}
+
+	slices.SortFunc(s.Labels, func(a, b Label) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(s.RelationshipTypes, func(a, b RelationshipType) int { return cmp.Compare(a.Type, b.Type) })
+	return s, nil
+}
+
+// addProperty records the types of a property of a label or relationship type.
+// The db.schema procedures report tokens without properties with a null property name.
+func addProperty(tokens map[string]map[string][]string, token string, name, types any) {
+	if tokens[token] == nil {
+		tokens[token] = make(map[string][]string)
+	}
+	propertyName, ok := name.(string)
+	if !ok {
+		return
+	}
+	for _, t := range stringList(types) {
+		t = nativeType(t)
+		if !slices.Contains(tokens[token][propertyName], t) {
+			tokens[token][propertyName] = append(tokens[token][propertyName], t)
+		}
+	}
+}
+
+// nativeProperties converts the property types of a label or relationship type, sorted by name
+func nativeProperties(types map[string][]string) []Property {
+	properties := make([]Property, 0, len(types))
+	for name, propertyTypes := range types {
+		slices.Sort(propertyTypes)
+		properties = append(properties, Property{Name: name, Type: strings.Join(propertyTypes, " | ")})
+	}
+	slices.SortFunc(properties, func(a, b Property) int { return cmp.Compare(a.Name, b.Name) })
+	return properties
+}
+
+// nativeType converts a property type reported by the db.schema procedures, e.g. "Long" or "StringArray", to its Cypher type
+func nativeType(t string) string {
+	if strings.HasSuffix(t, "Array") || strings.HasPrefix(t, "LIST") {
+		return "LIST"
+	}
+	if cypherType, ok := nativeTypes[t]; ok {
+		return cypherType
+	}
+	return strings.ToUpper(t)
+}
+
+// relTypeName extracts the name of a relationship type reported by db.schema.relTypeProperties, e.g. ":`ACTED_IN`"
+func relTypeName(relType string) string {
+	name := strings.TrimPrefix(relType, ":")
+	if len(name) >= 2 && strings.HasPrefix(name, "`") && strings.HasSuffix(name, "`") {
+		name = strings.ReplaceAll(name[1:len(name)-1], "``", "`")
+	}
+	return name
+}
+
+// visualizationPatterns collects the labels connected by each relationship type from the virtual graph of db.schema.visualization
+func visualizationPatterns(records []*neo4j.Record) map[string][]Pattern {
+	patterns := make(map[string][]Pattern)
+	for _, record := range records {
+		values := record.AsMap()
+		labels := make(map[string]string)
+		nodes, _ := values["nodes"].([]any)
+		for _, n := range nodes {
+			if node, ok := n.(neo4j.Node); ok && len(node.Labels) > 0 {
+				labels[node.ElementId] = node.Labels[0]
+			}
+		}
+		relationships, _ := values["relationships"].([]any)
+		for _, r := range relationships {
+			rel, ok := r.(neo4j.Relationship)
+			if !ok {
+				continue
+			}
+			start, startOK := labels[rel.StartElementId]
+			end, endOK := labels[rel.EndElementId]
+			pattern := Pattern{Start: start, End: end}
+			if startOK && endOK && !slices.Contains(patterns[rel.Type], pattern) {
+				patterns[rel.Type] = append(patterns[rel.Type], pattern)
+			}
+		}
+	}
+	return patterns
+}
+
+// counts counts the nodes of each label or the relationships of each type, in a single query answered from the count store.
+// pattern is the Cypher pattern matching a token, e.g. "(:%s)".
+func counts(ctx context.Context, executor database.QueryExecutor, pattern string, names []string) (map[string]int64, error) {
+	result := make(map[string]int64, len(names))
+	if len(names) == 0 {
+		return result, nil
+	}
+	slices.Sort(names)
+
+	parts := make([]string, 0, len(names))
+	params := make(map[string]any, len(names))
+	for i, name := range names {
+		param := fmt.Sprintf("name%d", i)
+		params[param] = name
+		parts = append(parts, fmt.Sprintf("MATCH "+pattern+" RETURN $%s AS name, count(*) AS count", quoteName(name), param))
+	}
+
+	records, err := executor.ExecuteReadQuery(ctx, strings.Join(parts, " UNION ALL "), params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count the graph entities: %w", err)
+	}
+	for _, record := range records {
+		values := record.AsMap()
+		result[stringValue(values["name"])] = int64Value(values["count"])
+	}
+	return result, nil
+}
+
+// quoteName quotes a label or relationship type for use in a Cypher pattern
+func quoteName(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func mapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
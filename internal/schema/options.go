@@ -0,0 +1,137 @@
+package schema
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// Options scope the introspection of a schema. Patterns are matched against label and relationship type names with path.Match,
+// e.g. "Person" or "Order*"; the include patterns keep every name when empty.
+type Options struct {
+	SampleSize               int // number of nodes sampled per label by APOC, 0 uses the APOC default
+	IncludeLabels            []string
+	ExcludeLabels            []string
+	IncludeRelationshipTypes []string
+	ExcludeRelationshipTypes []string
+	MaxProperties            int // maximum number of properties listed per label or relationship type, 0 lists them all
+}
+
+// Validate returns an error if the options hold a negative number or an invalid pattern
+func (o Options) Validate() error {
+	if o.SampleSize < 0 {
+		return fmt.Errorf("sample size must not be negative but was %d", o.SampleSize)
+	}
+	if o.MaxProperties < 0 {
+		return fmt.Errorf("max properties must not be negative but was %d", o.MaxProperties)
+	}
+	for _, patterns := range [][]string{o.IncludeLabels, o.ExcludeLabels, o.IncludeRelationshipTypes, o.ExcludeRelationshipTypes} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// labelIncluded reports whether the options keep the label
+func (o Options) labelIncluded(name string) bool {
+	return included(name, o.IncludeLabels, o.ExcludeLabels)
+}
+
+// relationshipTypeIncluded reports whether the options keep the relationship type
+func (o Options) relationshipTypeIncluded(name string) bool {
+	return included(name, o.IncludeRelationshipTypes, o.ExcludeRelationshipTypes)
+}
+
+func included(name string, include, exclude []string) bool {
+	if len(include) > 0 && !slices.ContainsFunc(include, func(pattern string) bool { return matches(pattern, name) }) {
+		return false
+	}
+	return !slices.ContainsFunc(exclude, func(pattern string) bool { return matches(pattern, name) })
+}
+
+func matches(pattern, name string) bool {
+	ok, _ := path.Match(pattern, name)
+	return ok
+}
+
+// apocConfig returns the apoc.meta.schema() configuration restricting its sampling to the options.
+// Patterns are only passed to APOC when they are plain names, the others are applied once the schema is read.
+func (o Options) apocConfig() map[string]any {
+	config := make(map[string]any)
+	if o.SampleSize > 0 {
+		config["sample"] = o.SampleSize
+	}
+	for key, patterns := range map[string][]string{
+		"labels":        o.IncludeLabels,
+		"excludeLabels": o.ExcludeLabels,
+		"rels":          o.IncludeRelationshipTypes,
+		"excludeRels":   o.ExcludeRelationshipTypes,
+	} {
+		names := make([]any, 0, len(patterns))
+		for _, pattern := range patterns {
+			if strings.ContainsAny(pattern, `*?[\`) {
+				names = nil
+				break
+			}
+			names = append(names, pattern)
+		}
+		if len(names) > 0 {
+			config[key] = names
+		}
+	}
+	return config
+}
+
+// scope removes the labels, relationship types, indexes and constraints excluded by the options,
+// and caps the number of properties listed
+func (s *Schema) scope(o Options) {
+	s.Labels = slices.DeleteFunc(s.Labels, func(l Label) bool { return !o.labelIncluded(l.Name) })
+	s.RelationshipTypes = slices.DeleteFunc(s.RelationshipTypes, func(r RelationshipType) bool { return !o.relationshipTypeIncluded(r.Type) })
+	s.Indexes = slices.DeleteFunc(s.Indexes, func(i Index) bool { return !o.tokensIncluded(i.EntityType, i.LabelsOrTypes) })
+	s.Constraints = slices.DeleteFunc(s.Constraints, func(c Constraint) bool { return !o.tokensIncluded(c.EntityType, c.LabelsOrTypes) })
+
+	if o.MaxProperties == 0 {
+		return
+	}
+	for i := range s.Labels {
+		s.Labels[i].Properties, s.Labels[i].OmittedProperties = capProperties(s.Labels[i].Properties, o.MaxProperties)
+	}
+	for i := range s.RelationshipTypes {
+		s.RelationshipTypes[i].Properties, s.RelationshipTypes[i].OmittedProperties = capProperties(s.RelationshipTypes[i].Properties, o.MaxProperties)
+	}
+}
+
+// tokensIncluded reports whether the options keep any of the labels or relationship types of an index or constraint
+func (o Options) tokensIncluded(entityType string, labelsOrTypes []string) bool {
+	if entityType == "RELATIONSHIP" {
+		return slices.ContainsFunc(labelsOrTypes, o.relationshipTypeIncluded)
+	}
+	return slices.ContainsFunc(labelsOrTypes, o.labelIncluded)
+}
+
+// capProperties keeps at most limit properties, unique, required and indexed ones first, and returns the number of properties left out
+func capProperties(properties []Property, limit int) ([]Property, int) {
+	if len(properties) <= limit {
+		return properties, 0
+	}
+	kept := slices.Clone(properties)
+	slices.SortStableFunc(kept, func(a, b Property) int { return rank(a) - rank(b) })
+	kept = kept[:limit]
+	slices.SortFunc(kept, func(a, b Property) int { return strings.Compare(a.Name, b.Name) })
+	return kept, len(properties) - limit
+}
+
+// rank orders the properties most useful to write queries first
+func rank(p Property) int {
+	switch {
+	case p.Unique || p.Required:
+		return 0
+	case p.Indexed:
+		return 1
+	}
+	return 2
+}
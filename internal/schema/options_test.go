@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr bool
+	}{
+		{name: "no options", opts: Options{}},
+		{name: "valid options", opts: Options{SampleSize: 100, IncludeLabels: []string{"Order*"}, MaxProperties: 5}},
+		{name: "negative sample size", opts: Options{SampleSize: -1}, wantErr: true},
+		{name: "negative max properties", opts: Options{MaxProperties: -1}, wantErr: true},
+		{name: "invalid pattern", opts: Options{ExcludeRelationshipTypes: []string{"HAS_["}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOptionsAPOCConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		want map[string]any
+	}{
+		{name: "no options", opts: Options{}, want: map[string]any{}},
+		{
+			name: "plain names are passed to APOC",
+			opts: Options{SampleSize: 100, IncludeLabels: []string{"Person", "Movie"}, ExcludeRelationshipTypes: []string{"KNOWS"}},
+			want: map[string]any{"sample": 100, "labels": []any{"Person", "Movie"}, "excludeRels": []any{"KNOWS"}},
+		},
+		{
+			name: "patterns are not passed to APOC",
+			opts: Options{IncludeLabels: []string{"Person", "Order*"}, IncludeRelationshipTypes: []string{"ACTED_IN"}},
+			want: map[string]any{"rels": []any{"ACTED_IN"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opts.apocConfig(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("apocConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchemaScope(t *testing.T) {
+	newSchema := func() *Schema {
+		return &Schema{
+			Labels: []Label{
+				{Name: "Order", Properties: []Property{{Name: "a", Type: "STRING"}, {Name: "id", Type: "STRING", Unique: true}, {Name: "total", Type: "FLOAT", Indexed: true}}},
+				{Name: "OrderLine", Properties: []Property{{Name: "quantity", Type: "INTEGER"}}},
+				{Name: "Person", Properties: []Property{{Name: "name", Type: "STRING"}}},
+			},
+			RelationshipTypes: []RelationshipType{
+				{Type: "HAS_LINE", Patterns: []Pattern{{Start: "Order", End: "OrderLine"}}},
+				{Type: "KNOWS", Patterns: []Pattern{{Start: "Person", End: "Person"}}},
+			},
+			Indexes: []Index{
+				{Name: "order_total", EntityType: "NODE", LabelsOrTypes: []string{"Order"}, Properties: []string{"total"}},
+				{Name: "knows_since", EntityType: "RELATIONSHIP", LabelsOrTypes: []string{"KNOWS"}, Properties: []string{"since"}},
+			},
+			Constraints: []Constraint{
+				{Name: "person_name", EntityType: "NODE", LabelsOrTypes: []string{"Person"}, Properties: []string{"name"}},
+			},
+		}
+	}
+
+	t.Run("include and exclude patterns", func(t *testing.T) {
+		s := newSchema()
+		s.scope(Options{IncludeLabels: []string{"Order*"}, ExcludeLabels: []string{"OrderLine"}, ExcludeRelationshipTypes: []string{"KNOWS"}})
+
+		if len(s.Labels) != 1 || s.Labels[0].Name != "Order" {
+			t.Errorf("Labels: got %+v, want only Order", s.Labels)
+		}
+		if len(s.RelationshipTypes) != 1 || s.RelationshipTypes[0].Type != "HAS_LINE" {
+			t.Errorf("RelationshipTypes: got %+v, want only HAS_LINE", s.RelationshipTypes)
+		}
+		if len(s.Indexes) != 1 || s.Indexes[0].Name != "order_total" {
+			t.Errorf("Indexes: got %+v, want only order_total", s.Indexes)
+		}
+		if len(s.Constraints) != 0 {
+			t.Errorf("Constraints: got %+v, want none", s.Constraints)
+		}
+	})
+
+	t.Run("max properties", func(t *testing.T) {
+		s := newSchema()
+		s.scope(Options{MaxProperties: 2})
+
+		want := []Property{{Name: "id", Type: "STRING", Unique: true}, {Name: "total", Type: "FLOAT", Indexed: true}}
+		if !reflect.DeepEqual(s.Labels[0].Properties, want) || s.Labels[0].OmittedProperties != 1 {
+			t.Errorf("Order: got %+v (%d omitted), want %+v (1 omitted)", s.Labels[0].Properties, s.Labels[0].OmittedProperties, want)
+		}
+		if len(s.Labels[1].Properties) != 1 || s.Labels[1].OmittedProperties != 0 {
+			t.Errorf("OrderLine: got %+v (%d omitted), want its only property", s.Labels[1].Properties, s.Labels[1].OmittedProperties)
+		}
+	})
+}
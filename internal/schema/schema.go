@@ -0,0 +1,116 @@
+// Package schema describes the graph model of a Neo4j database and reads it from the database.
+package schema
+
+import "slices"
+
+// Schema describes the graph model of a Neo4j database
+type Schema struct {
+	Labels            []Label            `json:"labels" jsonschema:"description=Node labels with their properties"`
+	RelationshipTypes []RelationshipType `json:"relationshipTypes" jsonschema:"description=Relationship types with their properties and the labels they connect"`
+	Indexes           []Index            `json:"indexes" jsonschema:"description=Indexes, token lookup indexes excluded"`
+	Constraints       []Constraint       `json:"constraints" jsonschema:"description=Constraints"`
+}
+
+// Label describes the nodes with a label
+type Label struct {
+	Name       string     `json:"name"`
+	Count      int64      `json:"count" jsonschema:"description=Number of nodes with the label"`
+	Properties []Property `json:"properties"`
+
+	OmittedProperties int `json:"omittedProperties,omitempty" jsonschema:"description=Number of properties left out of the properties list"`
+}
+
+// RelationshipType describes the relationships of a type
+type RelationshipType struct {
+	Type       string     `json:"type"`
+	Count      int64      `json:"count" jsonschema:"description=Number of relationships of the type"`
+	Properties []Property `json:"properties"`
+	Patterns   []Pattern  `json:"patterns" jsonschema:"description=Labels of the start and end nodes of the relationships"`
+
+	OmittedProperties int `json:"omittedProperties,omitempty" jsonschema:"description=Number of properties left out of the properties list"`
+}
+
+// Pattern is a pair of labels connected by relationships of a type
+type Pattern struct {
+	Start string `json:"start" jsonschema:"description=Label of the start nodes"`
+	End   string `json:"end" jsonschema:"description=Label of the end nodes"`
+}
+
+// Property describes a property of nodes or relationships
+type Property struct {
+	Name     string `json:"name"`
+	Type     string `json:"type" jsonschema:"description=Cypher type of the property values, e.g. STRING, INTEGER or LIST"`
+	Indexed  bool   `json:"indexed,omitempty"`
+	Unique   bool   `json:"unique,omitempty"`
+	Required bool   `json:"required,omitempty" jsonschema:"description=Whether a constraint requires the property to exist"`
+}
+
+// Index describes an index
+type Index struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type" jsonschema:"description=Index type, e.g. RANGE, TEXT, POINT, FULLTEXT or VECTOR"`
+	EntityType    string   `json:"entityType" jsonschema:"enum=NODE,enum=RELATIONSHIP"`
+	LabelsOrTypes []string `json:"labelsOrTypes"`
+	Properties    []string `json:"properties"`
+	State         string   `json:"state,omitempty"`
+}
+
+// Constraint describes a constraint
+type Constraint struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type" jsonschema:"description=Constraint type, e.g. UNIQUENESS, NODE_KEY or NODE_PROPERTY_EXISTENCE"`
+	EntityType    string   `json:"entityType" jsonschema:"enum=NODE,enum=RELATIONSHIP"`
+	LabelsOrTypes []string `json:"labelsOrTypes"`
+	Properties    []string `json:"properties"`
+}
+
+// IsEmpty reports whether the schema holds no label nor relationship type, as for a database without data
+func (s *Schema) IsEmpty() bool {
+	return len(s.Labels) == 0 && len(s.RelationshipTypes) == 0
+}
+
+// LabelSchema describes the nodes with a label, the relationships they have and the indexes and constraints applying to them
+type LabelSchema struct {
+	Label
+	RelationshipTypes []RelationshipType `json:"relationshipTypes" jsonschema:"description=Relationship types starting or ending at nodes with the label"`
+	Indexes           []Index            `json:"indexes"`
+	Constraints       []Constraint       `json:"constraints"`
+}
+
+// LabelSchema returns the part of the schema describing the named label
+func (s *Schema) LabelSchema(name string) (*LabelSchema, bool) {
+	idx := slices.IndexFunc(s.Labels, func(l Label) bool { return l.Name == name })
+	if idx < 0 {
+		return nil, false
+	}
+
+	ls := &LabelSchema{
+		Label:             s.Labels[idx],
+		RelationshipTypes: make([]RelationshipType, 0),
+		Indexes:           make([]Index, 0),
+		Constraints:       make([]Constraint, 0),
+	}
+	for _, r := range s.RelationshipTypes {
+		patterns := make([]Pattern, 0)
+		for _, p := range r.Patterns {
+			if p.Start == name || p.End == name {
+				patterns = append(patterns, p)
+			}
+		}
+		if len(patterns) > 0 {
+			r.Patterns = patterns
+			ls.RelationshipTypes = append(ls.RelationshipTypes, r)
+		}
+	}
+	for _, i := range s.Indexes {
+		if i.EntityType == "NODE" && slices.Contains(i.LabelsOrTypes, name) {
+			ls.Indexes = append(ls.Indexes, i)
+		}
+	}
+	for _, c := range s.Constraints {
+		if c.EntityType == "NODE" && slices.Contains(c.LabelsOrTypes, name) {
+			ls.Constraints = append(ls.Constraints, c)
+		}
+	}
+	return ls, true
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Text renders the schema as compact Cypher-like text, for clients that do not support structured output
+func (s *Schema) Text() string {
+	var b strings.Builder
+
+	b.WriteString("Node labels:\n")
+	for _, l := range s.Labels {
+		fmt.Fprintf(&b, "(:%s%s) %d nodes\n", l.Name, propertiesText(l.Properties, l.OmittedProperties), l.Count)
+	}
+
+	b.WriteString("Relationship types:\n")
+	for _, r := range s.RelationshipTypes {
+		if len(r.Patterns) == 0 {
+			fmt.Fprintf(&b, "()-[:%s%s]->() %d relationships\n", r.Type, propertiesText(r.Properties, r.OmittedProperties), r.Count)
+			continue
+		}
+		for _, p := range r.Patterns {
+			fmt.Fprintf(&b, "(:%s)-[:%s%s]->(:%s) %d relationships\n", p.Start, r.Type, propertiesText(r.Properties, r.OmittedProperties), p.End, r.Count)
+		}
+	}
+
+	if len(s.Indexes) > 0 {
+		b.WriteString("Indexes:\n")
+		for _, i := range s.Indexes {
+			fmt.Fprintf(&b, "%s INDEX %s FOR %s ON (%s)", i.Type, i.Name, entityText(i.EntityType, i.LabelsOrTypes), strings.Join(i.Properties, ", "))
+			if i.State != "" && i.State != "ONLINE" {
+				fmt.Fprintf(&b, " %s", i.State)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(s.Constraints) > 0 {
+		b.WriteString("Constraints:\n")
+		for _, c := range s.Constraints {
+			fmt.Fprintf(&b, "%s CONSTRAINT %s FOR %s REQUIRE (%s)\n", c.Type, c.Name, entityText(c.EntityType, c.LabelsOrTypes), strings.Join(c.Properties, ", "))
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// propertiesText renders properties as a Cypher map of their types, e.g. " {name: STRING, born: INTEGER, +3 more}"
+func propertiesText(properties []Property, omitted int) string {
+	if len(properties) == 0 && omitted == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(properties)+1)
+	for _, p := range properties {
+		parts = append(parts, p.Name+": "+p.Type)
+	}
+	if omitted > 0 {
+		parts = append(parts, fmt.Sprintf("+%d more", omitted))
+	}
+	return " {" + strings.Join(parts, ", ") + "}"
+}
+
+// entityText renders the nodes or relationships an index or constraint applies to, e.g. "(:Person)" or "()-[:KNOWS]-()"
+func entityText(entityType string, labelsOrTypes []string) string {
+	tokens := strings.Join(labelsOrTypes, "|")
+	if entityType == "RELATIONSHIP" {
+		return "()-[:" + tokens + "]-()"
+	}
+	return "(:" + tokens + ")"
+}
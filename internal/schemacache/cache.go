@@ -0,0 +1,126 @@
+// Package schemacache keeps the schemas read by get-schema, so repeated calls do not introspect the database again.
+package schemacache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/neo4j/mcp/internal/schema"
+)
+
+// maxEntriesPerDatabase bounds the variants cached per database, as variants combine the credentials and the options of tool calls
+const maxEntriesPerDatabase = 64
+
+type entry struct {
+	schema  *schema.Schema
+	expires time.Time
+}
+
+type databaseEntries struct {
+	// generation is incremented by every invalidation, so schemas read before it are not stored after it
+	generation uint64
+	entries    map[string]*entry
+}
+
+// Cache holds schemas per database. Each database holds one schema per variant, a key identifying how the schema was read,
+// e.g. its scoping options. Schemas expire after their time to live or when their database is invalidated,
+// and each database holds at most maxEntriesPerDatabase of them, caching one more removes the one expiring first.
+type Cache struct {
+	ttl time.Duration
+	now func() time.Time
+	// onInvalidate is called after a database is invalidated
+	onInvalidate func(database string)
+
+	mu        sync.Mutex
+	databases map[string]*databaseEntries
+}
+
+// New creates a Cache whose schemas expire after ttl
+func New(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:       ttl,
+		now:       time.Now,
+		databases: make(map[string]*databaseEntries),
+	}
+}
+
+// Get returns the schema cached for the database and variant, if any, and the generation of the database to pass to Put
+func (c *Cache) Get(database, variant string) (*schema.Schema, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d := c.database(database)
+	e, ok := d.entries[variant]
+	if !ok {
+		return nil, d.generation, false
+	}
+	if !c.now().Before(e.expires) {
+		delete(d.entries, variant)
+		return nil, d.generation, false
+	}
+	return e.schema, d.generation, true
+}
+
+// Put caches the schema of the database and variant, unless the database was invalidated since generation was returned by Get
+func (c *Cache) Put(database, variant string, generation uint64, s *schema.Schema) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d := c.database(database)
+	if d.generation != generation {
+		return
+	}
+	now := c.now()
+	for v, e := range d.entries {
+		if !now.Before(e.expires) {
+			delete(d.entries, v)
+		}
+	}
+	if _, ok := d.entries[variant]; !ok && len(d.entries) >= maxEntriesPerDatabase {
+		d.evictFirstExpiring()
+	}
+	d.entries[variant] = &entry{schema: s, expires: now.Add(c.ttl)}
+}
+
+// OnInvalidate sets a function called after a database is invalidated
+func (c *Cache) OnInvalidate(fn func(database string)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onInvalidate = fn
+}
+
+// Invalidate removes every schema cached for the database, after its schema changed
+func (c *Cache) Invalidate(database string) {
+	c.mu.Lock()
+	d := c.database(database)
+	d.generation++
+	clear(d.entries)
+	onInvalidate := c.onInvalidate
+	c.mu.Unlock()
+
+	if onInvalidate != nil {
+		onInvalidate(database)
+	}
+}
+
+// evictFirstExpiring removes the entry expiring first
+func (d *databaseEntries) evictFirstExpiring() {
+	var first string
+	var expires time.Time
+	for v, e := range d.entries {
+		if expires.IsZero() || e.expires.Before(expires) {
+			first, expires = v, e.expires
+		}
+	}
+	delete(d.entries, first)
+}
+
+// database returns the entries of the database, creating them if needed; c.mu must be held
+func (c *Cache) database(name string) *databaseEntries {
+	d, ok := c.databases[name]
+	if !ok {
+		d = &databaseEntries{entries: make(map[string]*entry)}
+		c.databases[name] = d
+	}
+	return d
+}
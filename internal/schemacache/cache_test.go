@@ -0,0 +1,131 @@
+package schemacache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/neo4j/mcp/internal/schema"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newCache := func() *Cache {
+		c := New(time.Minute)
+		c.now = func() time.Time { return now }
+		return c
+	}
+	movies := &schema.Schema{Labels: []schema.Label{{Name: "Movie"}}}
+
+	t.Run("put and get", func(t *testing.T) {
+		c := newCache()
+		_, generation, ok := c.Get("neo4j", "")
+		if ok {
+			t.Fatal("Get() on an empty cache returned a schema")
+		}
+		c.Put("neo4j", "", generation, movies)
+
+		got, _, ok := c.Get("neo4j", "")
+		if !ok || got != movies {
+			t.Errorf("Get() = %v, %v, want the cached schema", got, ok)
+		}
+	})
+
+	t.Run("schemas are cached per database and variant", func(t *testing.T) {
+		c := newCache()
+		c.Put("neo4j", "", 0, movies)
+
+		if _, _, ok := c.Get("other", ""); ok {
+			t.Error("Get() returned the schema of another database")
+		}
+		if _, _, ok := c.Get("neo4j", "labels=Person"); ok {
+			t.Error("Get() returned the schema of another variant")
+		}
+	})
+
+	t.Run("schemas expire", func(t *testing.T) {
+		c := newCache()
+		c.Put("neo4j", "", 0, movies)
+		defer func() { now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC) }()
+		now = now.Add(time.Minute)
+
+		if _, _, ok := c.Get("neo4j", ""); ok {
+			t.Error("Get() returned an expired schema")
+		}
+	})
+
+	t.Run("put removes expired schemas", func(t *testing.T) {
+		c := newCache()
+		c.Put("neo4j", "labels=Movie", 0, movies)
+		defer func() { now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC) }()
+		now = now.Add(time.Minute)
+		c.Put("neo4j", "", 0, movies)
+
+		if n := len(c.databases["neo4j"].entries); n != 1 {
+			t.Errorf("expected the expired schema to be removed, got %d entries", n)
+		}
+	})
+
+	t.Run("each database holds a bounded number of schemas", func(t *testing.T) {
+		c := newCache()
+		defer func() { now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC) }()
+		for i := range maxEntriesPerDatabase + 1 {
+			c.Put("neo4j", fmt.Sprintf("variant-%d", i), 0, movies)
+			now = now.Add(time.Millisecond)
+		}
+
+		if n := len(c.databases["neo4j"].entries); n != maxEntriesPerDatabase {
+			t.Errorf("expected %d entries, got %d", maxEntriesPerDatabase, n)
+		}
+		if _, _, ok := c.Get("neo4j", "variant-0"); ok {
+			t.Error("expected the schema expiring first to be removed")
+		}
+		if _, _, ok := c.Get("neo4j", fmt.Sprintf("variant-%d", maxEntriesPerDatabase)); !ok {
+			t.Error("expected the last schema to be cached")
+		}
+	})
+
+	t.Run("invalidate removes the schemas of the database", func(t *testing.T) {
+		c := newCache()
+		c.Put("neo4j", "", 0, movies)
+		c.Put("neo4j", "labels=Movie", 0, movies)
+		c.Put("other", "", 0, movies)
+		c.Invalidate("neo4j")
+
+		if _, _, ok := c.Get("neo4j", ""); ok {
+			t.Error("Get() returned an invalidated schema")
+		}
+		if _, _, ok := c.Get("neo4j", "labels=Movie"); ok {
+			t.Error("Get() returned an invalidated schema variant")
+		}
+		if _, _, ok := c.Get("other", ""); !ok {
+			t.Error("Invalidate() removed the schema of another database")
+		}
+	})
+
+	t.Run("invalidate calls the invalidation function", func(t *testing.T) {
+		c := newCache()
+		c.Put("neo4j", "", 0, &schema.Schema{Labels: []schema.Label{{Name: "Person"}, {Name: "Movie"}}})
+		c.Put("neo4j", "labels=Movie", 0, movies)
+		var gotDatabase string
+		c.OnInvalidate(func(database string) {
+			gotDatabase = database
+		})
+		c.Invalidate("neo4j")
+
+		if gotDatabase != "neo4j" {
+			t.Errorf("invalidation function called with %q, want %q", gotDatabase, "neo4j")
+		}
+	})
+
+	t.Run("schemas read before an invalidation are not cached", func(t *testing.T) {
+		c := newCache()
+		_, generation, _ := c.Get("neo4j", "")
+		c.Invalidate("neo4j")
+		c.Put("neo4j", "", generation, movies)
+
+		if _, _, ok := c.Get("neo4j", ""); ok {
+			t.Error("Get() returned a schema read before the invalidation")
+		}
+	})
+}
@@ -0,0 +1,387 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/config"
+)
+
+// ErrNoCredentials is returned by an Authenticator when the request carries no credentials at all
+var ErrNoCredentials = errors.New("no credentials provided")
+
+// jwtLeeway is the clock skew tolerated when validating JWT time based claims
+const jwtLeeway = 30 * time.Second
+
+// Authenticator authenticates HTTP requests made to the MCP endpoint.
+// Implementations return ErrNoCredentials when the request carries no credentials,
+// and any other error when the credentials are invalid.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*auth.Principal, error)
+}
+
+// newAuthenticator creates the Authenticator selected by the configuration, or nil when authentication is disabled
+func newAuthenticator(cfg *config.Config) (Authenticator, error) {
+	switch cfg.HTTPAuth {
+	case config.HTTPAuthAPIKey:
+		return NewAPIKeyAuthenticator(cfg.HTTPAPIKeysFile)
+	case config.HTTPAuthJWT:
+		return NewJWTAuthenticator(cfg.HTTPJWTKeyFile, cfg.HTTPJWTIssuer, cfg.HTTPJWTAudience)
+	default:
+		return nil, nil
+	}
+}
+
+// authenticationMiddleware authenticates every HTTP request carrying credentials and attaches the principal to its context.
+// Requests with invalid credentials are rejected; requests without credentials are passed on unauthenticated
+// so that clients can still initialize and list tools, while tool calls are rejected by requireAuthenticatedToolCall.
+func authenticationMiddleware(authenticator Authenticator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := authenticator.Authenticate(r)
+		switch {
+		case errors.Is(err, ErrNoCredentials):
+			next.ServeHTTP(w, r)
+		case err != nil:
+			log.Printf("Rejected HTTP request with invalid credentials: %v", err)
+			w.Header().Set("WWW-Authenticate", `Bearer realm="neo4j-mcp"`)
+			http.Error(w, "invalid credentials", http.StatusUnauthorized)
+		default:
+			next.ServeHTTP(w, r.WithContext(auth.NewContext(r.Context(), principal)))
+		}
+	})
+}
+
+// requireAuthenticatedToolCall rejects tool calls that were not made by an authenticated principal
+func requireAuthenticatedToolCall(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if _, ok := auth.PrincipalFromContext(ctx); !ok {
+			errMessage := "Authentication required: provide a valid API key or token in the Authorization header"
+			log.Printf("Rejected unauthenticated call to tool %s", request.Params.Name)
+			return mcp.NewToolResultError(errMessage), nil
+		}
+		return next(ctx, request)
+	}
+}
+
+// requireAuthenticatedResourceRead rejects resource reads that were not made by an authenticated principal
+func requireAuthenticatedResourceRead(next server.ResourceHandlerFunc) server.ResourceHandlerFunc {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		if _, ok := auth.PrincipalFromContext(ctx); !ok {
+			log.Printf("Rejected unauthenticated read of resource %s", request.Params.URI)
+			return nil, errors.New("authentication required: provide a valid API key or token in the Authorization header")
+		}
+		return next(ctx, request)
+	}
+}
+
+// requireAuthenticatedPromptGet rejects prompt requests that were not made by an authenticated principal
+func requireAuthenticatedPromptGet(next server.PromptHandlerFunc) server.PromptHandlerFunc {
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		if _, ok := auth.PrincipalFromContext(ctx); !ok {
+			log.Printf("Rejected unauthenticated request for prompt %s", request.Params.Name)
+			return nil, errors.New("authentication required: provide a valid API key or token in the Authorization header")
+		}
+		return next(ctx, request)
+	}
+}
+
+// bearerToken returns the token of a "Bearer" Authorization header, or an empty string
+func bearerToken(r *http.Request) string {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// APIKeyAuthenticator authenticates requests using static API keys.
+// The key is read from the "Authorization: Bearer <key>" header or the "X-API-Key" header.
+type APIKeyAuthenticator struct {
+	keys map[[sha256.Size]byte]string // hash of the key -> key name
+}
+
+// NewAPIKeyAuthenticator loads API keys from a file.
+// Each non-empty line holds either "<name> <key>" or a single "<key>"; lines starting with '#' are ignored.
+func NewAPIKeyAuthenticator(path string) (*APIKeyAuthenticator, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path comes from the server configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API keys file: %w", err)
+	}
+
+	a := &APIKeyAuthenticator{keys: make(map[[sha256.Size]byte]string)}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		var name, key string
+		switch len(fields) {
+		case 1:
+			name, key = fmt.Sprintf("api-key-%d", line), fields[0]
+		case 2:
+			name, key = fields[0], fields[1]
+		default:
+			return nil, fmt.Errorf("invalid API keys file: line %d must contain \"<name> <key>\" or \"<key>\"", line)
+		}
+		a.keys[sha256.Sum256([]byte(key))] = name
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read API keys file: %w", err)
+	}
+	if len(a.keys) == 0 {
+		return nil, fmt.Errorf("API keys file %s contains no keys", path)
+	}
+
+	return a, nil
+}
+
+// Authenticate implements Authenticator
+func (a *APIKeyAuthenticator) Authenticate(r *http.Request) (*auth.Principal, error) {
+	key := r.Header.Get("X-API-Key")
+	if key == "" {
+		key = bearerToken(r)
+	}
+	if key == "" {
+		return nil, ErrNoCredentials
+	}
+
+	// Compare hashes in constant time so that the key cannot be guessed from response timings
+	hash := sha256.Sum256([]byte(key))
+	for knownHash, name := range a.keys {
+		if subtle.ConstantTimeCompare(hash[:], knownHash[:]) == 1 {
+			return &auth.Principal{Subject: name, Method: config.HTTPAuthAPIKey}, nil
+		}
+	}
+	return nil, errors.New("unknown API key")
+}
+
+// JWTAuthenticator authenticates requests carrying a JWT in the "Authorization: Bearer <token>" header.
+// Tokens are validated against locally configured public keys; no network calls are made.
+type JWTAuthenticator struct {
+	keys   map[string]any // key ID -> public key; a single PEM key is stored under the empty key ID
+	parser *jwt.Parser
+}
+
+// NewJWTAuthenticator creates a JWTAuthenticator from a file holding either a JWKS document or PEM encoded public keys.
+// When issuer or audience are not empty, tokens must carry the matching "iss" or "aud" claim.
+func NewJWTAuthenticator(keyFile, issuer, audience string) (*JWTAuthenticator, error) {
+	data, err := os.ReadFile(keyFile) // #nosec G304 -- path comes from the server configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT key file: %w", err)
+	}
+
+	var keys map[string]any
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		keys, err = parseJWKS(trimmed)
+	} else {
+		keys, err = parsePEMPublicKey(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load JWT keys from %s: %w", keyFile, err)
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(jwtLeeway),
+	}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		opts = append(opts, jwt.WithAudience(audience))
+	}
+
+	return &JWTAuthenticator{keys: keys, parser: jwt.NewParser(opts...)}, nil
+}
+
+// Authenticate implements Authenticator
+func (a *JWTAuthenticator) Authenticate(r *http.Request) (*auth.Principal, error) {
+	raw := bearerToken(r)
+	if raw == "" {
+		return nil, ErrNoCredentials
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := a.parser.ParseWithClaims(raw, claims, a.keyFunc); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return nil, errors.New("invalid token: missing subject")
+	}
+
+	return &auth.Principal{Subject: subject, Method: config.HTTPAuthJWT, Claims: claims}, nil
+}
+
+// keyFunc selects the verification key by the token's "kid" header.
+// A key configured without an ID (e.g. a PEM key) verifies tokens regardless of their "kid" header.
+func (a *JWTAuthenticator) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if key, ok := a.keys[kid]; ok {
+		return key, nil
+	}
+	if key, ok := a.keys[""]; ok {
+		return key, nil
+	}
+	if kid == "" && len(a.keys) == 1 {
+		for _, key := range a.keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("no verification key found for key ID %q", kid)
+}
+
+// jsonWebKey holds the JWK members needed to build RSA, EC and Ed25519 public keys
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS parses the signature keys of a JSON Web Key Set
+func parseJWKS(data []byte) (map[string]any, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid JWKS document: %w", err)
+	}
+
+	keys := make(map[string]any, len(set.Keys))
+	for i, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWK at index %d: %w", i, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS document contains no signature keys")
+	}
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+			return nil, errors.New("exponent is too large")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) > size || len(y) > size {
+			return nil, errors.New("invalid coordinate length")
+		}
+		point := make([]byte, 1+2*size)
+		point[0] = 4 // uncompressed point
+		copy(point[1+size-len(x):1+size], x)
+		copy(point[1+2*size-len(y):], y)
+		return ecdsa.ParseUncompressedPublicKey(curve, point)
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 public key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// parsePEMPublicKey parses a single PEM encoded public key or certificate
+func parsePEMPublicKey(data []byte) (map[string]any, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate: %w", err)
+		}
+		return map[string]any{"": cert.PublicKey}, nil
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %w", err)
+		}
+		return map[string]any{"": key}, nil
+	case "RSA PUBLIC KEY":
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA public key: %w", err)
+		}
+		return map[string]any{"": key}, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
@@ -0,0 +1,267 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/auth"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func requestWithHeader(key, value string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	if value != "" {
+		r.Header.Set(key, value)
+	}
+	return r
+}
+
+func TestAPIKeyAuthenticator(t *testing.T) {
+	path := writeFile(t, "keys.txt", "# team keys\nalice key-for-alice\n\nanonymous-key\n")
+	authenticator, err := NewAPIKeyAuthenticator(path)
+	if err != nil {
+		t.Fatalf("NewAPIKeyAuthenticator() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		header      string
+		value       string
+		wantSubject string
+		wantErr     error
+		wantInvalid bool
+	}{
+		{name: "named key as bearer token", header: "Authorization", value: "Bearer key-for-alice", wantSubject: "alice"},
+		{name: "named key in X-API-Key header", header: "X-API-Key", value: "key-for-alice", wantSubject: "alice"},
+		{name: "unnamed key", header: "Authorization", value: "Bearer anonymous-key", wantSubject: "api-key-4"},
+		{name: "no credentials", header: "Authorization", value: "", wantErr: ErrNoCredentials},
+		{name: "unknown key", header: "Authorization", value: "Bearer nope", wantInvalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := authenticator.Authenticate(requestWithHeader(tt.header, tt.value))
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Authenticate() error = %v, want %v", err, tt.wantErr)
+				}
+			case tt.wantInvalid:
+				if err == nil || errors.Is(err, ErrNoCredentials) {
+					t.Errorf("Authenticate() error = %v, want invalid credentials error", err)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("Authenticate() unexpected error = %v", err)
+				}
+				if principal.Subject != tt.wantSubject || principal.Method != "api-key" {
+					t.Errorf("Authenticate() principal = %+v, want subject %q", principal, tt.wantSubject)
+				}
+			}
+		})
+	}
+
+	t.Run("empty keys file is rejected", func(t *testing.T) {
+		if _, err := NewAPIKeyAuthenticator(writeFile(t, "empty.txt", "# nothing\n")); err == nil {
+			t.Error("NewAPIKeyAuthenticator() expected error for empty file")
+		}
+	})
+
+	t.Run("malformed line is rejected", func(t *testing.T) {
+		if _, err := NewAPIKeyAuthenticator(writeFile(t, "bad.txt", "a b c\n")); err == nil {
+			t.Error("NewAPIKeyAuthenticator() expected error for malformed line")
+		}
+	})
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	pemFile := writeFile(t, "key.pem", string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+
+	point, err := key.PublicKey.Bytes()
+	if err != nil {
+		t.Fatalf("failed to encode key: %v", err)
+	}
+	jwks, _ := json.Marshal(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "EC",
+			"kid": "key-1",
+			"use": "sig",
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(point[1:33]),
+			"y":   base64.RawURLEncoding.EncodeToString(point[33:]),
+		}},
+	})
+	jwksFile := writeFile(t, "jwks.json", string(jwks))
+
+	sign := func(signingKey *ecdsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(signingKey)
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return signed
+	}
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"sub": "alice",
+			"iss": "https://issuer.example.com",
+			"aud": "neo4j-mcp",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}
+	}
+
+	for _, keyFile := range []string{pemFile, jwksFile} {
+		authenticator, err := NewJWTAuthenticator(keyFile, "https://issuer.example.com", "neo4j-mcp")
+		if err != nil {
+			t.Fatalf("NewJWTAuthenticator(%s) unexpected error = %v", filepath.Base(keyFile), err)
+		}
+
+		t.Run(filepath.Base(keyFile)+" accepts a valid token", func(t *testing.T) {
+			principal, err := authenticator.Authenticate(requestWithHeader("Authorization", "Bearer "+sign(key, "key-1", validClaims())))
+			if err != nil {
+				t.Fatalf("Authenticate() unexpected error = %v", err)
+			}
+			if principal.Subject != "alice" || principal.Method != "jwt" || principal.Claims["aud"] != "neo4j-mcp" {
+				t.Errorf("Authenticate() principal = %+v", principal)
+			}
+		})
+
+		t.Run(filepath.Base(keyFile)+" rejects invalid tokens", func(t *testing.T) {
+			expired := validClaims()
+			expired["exp"] = time.Now().Add(-time.Hour).Unix()
+			wrongAudience := validClaims()
+			wrongAudience["aud"] = "someone-else"
+			noSubject := validClaims()
+			delete(noSubject, "sub")
+
+			for name, token := range map[string]string{
+				"expired":        sign(key, "key-1", expired),
+				"wrong audience": sign(key, "key-1", wrongAudience),
+				"no subject":     sign(key, "key-1", noSubject),
+				"wrong key":      sign(otherKey, "key-1", validClaims()),
+				"garbage":        "not-a-jwt",
+			} {
+				if _, err := authenticator.Authenticate(requestWithHeader("Authorization", "Bearer "+token)); err == nil || errors.Is(err, ErrNoCredentials) {
+					t.Errorf("%s: Authenticate() error = %v, want invalid token error", name, err)
+				}
+			}
+		})
+
+		t.Run(filepath.Base(keyFile)+" reports missing credentials", func(t *testing.T) {
+			if _, err := authenticator.Authenticate(requestWithHeader("Authorization", "")); !errors.Is(err, ErrNoCredentials) {
+				t.Errorf("Authenticate() error = %v, want ErrNoCredentials", err)
+			}
+		})
+	}
+}
+
+type stubAuthenticator struct {
+	principal *auth.Principal
+	err       error
+}
+
+func (s stubAuthenticator) Authenticate(_ *http.Request) (*auth.Principal, error) {
+	return s.principal, s.err
+}
+
+func TestAuthenticationMiddleware(t *testing.T) {
+	tests := []struct {
+		name          string
+		authenticator Authenticator
+		wantStatus    int
+		wantPrincipal bool
+	}{
+		{name: "valid credentials", authenticator: stubAuthenticator{principal: &auth.Principal{Subject: "alice"}}, wantStatus: http.StatusOK, wantPrincipal: true},
+		{name: "no credentials", authenticator: stubAuthenticator{err: ErrNoCredentials}, wantStatus: http.StatusOK},
+		{name: "invalid credentials", authenticator: stubAuthenticator{err: errors.New("bad token")}, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPrincipal bool
+			handler := authenticationMiddleware(tt.authenticator, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				_, gotPrincipal = auth.PrincipalFromContext(r.Context())
+			}))
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/mcp", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if gotPrincipal != tt.wantPrincipal {
+				t.Errorf("principal in context = %v, want %v", gotPrincipal, tt.wantPrincipal)
+			}
+		})
+	}
+}
+
+func TestRequireAuthenticatedToolCall(t *testing.T) {
+	handler := requireAuthenticatedToolCall(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		principal, _ := auth.PrincipalFromContext(ctx)
+		return mcp.NewToolResultText(principal.Subject), nil
+	})
+
+	t.Run("rejects unauthenticated calls", func(t *testing.T) {
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("unexpected error = %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("expected error result for unauthenticated call")
+		}
+	})
+
+	t.Run("passes the principal to the tool handler", func(t *testing.T) {
+		ctx := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice"})
+		result, err := handler(ctx, mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("unexpected error = %v", err)
+		}
+		if result.IsError {
+			t.Fatal("expected success result")
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; text != "alice" {
+			t.Errorf("expected principal alice, got %q", text)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+// cancelledNotificationMethod is the MCP notification clients send to cancel an in-flight request
+const cancelledNotificationMethod = "notifications/cancelled"
+
+// errToolCallCancelled is the cause of the context of tool calls cancelled by the client
+var errToolCallCancelled = errors.New("tool call cancelled by the client")
+
+// callKey identifies a tool call, request IDs being unique within a client session only
+type callKey struct {
+	sessionID string
+	requestID string
+}
+
+// cancellations tracks in-flight tool calls so that notifications/cancelled can cancel their context,
+// which rolls back the Neo4j transactions they run.
+type cancellations struct {
+	mu    sync.Mutex
+	calls map[callKey]context.CancelCauseFunc
+}
+
+func newCancellations() *cancellations {
+	return &cancellations{calls: make(map[callKey]context.CancelCauseFunc)}
+}
+
+// middleware makes tool calls cancellable for as long as their handler runs
+func (c *cancellations) middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		id := requestID(request)
+		if id == "" {
+			return next(ctx, request)
+		}
+
+		ctx, cancel := context.WithCancelCause(ctx)
+		key := callKey{sessionID: tools.SessionID(ctx), requestID: id}
+		c.mu.Lock()
+		c.calls[key] = cancel
+		c.mu.Unlock()
+		defer func() {
+			c.mu.Lock()
+			delete(c.calls, key)
+			c.mu.Unlock()
+			cancel(nil)
+		}()
+
+		result, err := next(ctx, request)
+		if errors.Is(context.Cause(ctx), errToolCallCancelled) {
+			log.Printf("Cancelled call to tool %s (request %s)", request.Params.Name, id)
+			return mcp.NewToolResultError(fmt.Sprintf("The call to %s was cancelled by the client and its running query was terminated", request.Params.Name)), nil
+		}
+		return result, err
+	}
+}
+
+// handleNotification cancels the tool call identified by a notifications/cancelled notification, if it is still running
+func (c *cancellations) handleNotification(ctx context.Context, notification mcp.JSONRPCNotification) {
+	data, err := json.Marshal(notification.Params)
+	if err != nil {
+		log.Printf("Error reading cancelled notification: %v", err)
+		return
+	}
+	var params mcp.CancelledNotificationParams
+	if err := json.Unmarshal(data, &params); err != nil || params.RequestId.IsNil() {
+		log.Printf("Ignoring cancelled notification without a valid request ID: %s", data)
+		return
+	}
+
+	key := callKey{sessionID: tools.SessionID(ctx), requestID: fmt.Sprint(params.RequestId.Value())}
+	c.mu.Lock()
+	cancel, ok := c.calls[key]
+	c.mu.Unlock()
+	if ok {
+		log.Printf("Client cancelled request %s: %s", key.requestID, params.Reason)
+		cancel(errToolCallCancelled)
+	}
+}
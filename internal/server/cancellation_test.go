@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+// callTool sends a tools/call request to s and returns the result of the call
+func callTool(t *testing.T, s *Neo4jMCPServer, id, name string, arguments map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	message, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"method":  "tools/call",
+		"params":  map[string]any{"name": name, "arguments": arguments},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal request: %v", err)
+	}
+
+	response, ok := s.MCPServer.HandleMessage(context.Background(), message).(mcp.JSONRPCResponse)
+	if !ok {
+		t.Fatalf("expected a JSON-RPC response")
+	}
+	result, ok := response.Result.(mcp.CallToolResult)
+	if !ok {
+		t.Fatalf("expected a tool result, got %T", response.Result)
+	}
+	return &result
+}
+
+// cancelRequest sends a notifications/cancelled notification for the request with the given ID to s
+func cancelRequest(s *Neo4jMCPServer, id any) {
+	message, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"method":  cancelledNotificationMethod,
+		"params":  map[string]any{"requestId": id, "reason": "user aborted"},
+	})
+	s.MCPServer.HandleMessage(context.Background(), message)
+}
+
+func TestReadCypherCancellation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent(gomock.Any()).AnyTimes()
+	analyticsService.EXPECT().NewStartupEvent().AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+
+	started := make(chan struct{})
+	var queryErr error
+	mockDB := db.NewMockService(ctrl)
+	mockDB.EXPECT().GetQueryType(gomock.Any(), gomock.Any(), gomock.Any()).Return(neo4j.StatementTypeReadOnly, nil)
+	mockDB.EXPECT().
+		ExecuteReadQueryWithOptions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ string, _ map[string]any, _ database.ReadOptions) (*database.ReadResult, error) {
+			// Block like a long running query until the call is cancelled
+			close(started)
+			select {
+			case <-ctx.Done():
+				queryErr = ctx.Err()
+				return nil, ctx.Err()
+			case <-time.After(5 * time.Second):
+				return &database.ReadResult{}, nil
+			}
+		})
+
+	s := NewNeo4jMCPServer("test-version", &config.Config{Database: "neo4j"}, mockDB, analyticsService)
+	if err := s.RegisterTools(); err != nil {
+		t.Fatalf("RegisterTools() failed: %v", err)
+	}
+
+	go func() {
+		<-started
+		cancelRequest(s, 7)
+	}()
+	result := callTool(t, s, "7", "read-cypher", map[string]any{"query": "MATCH (n) RETURN n"})
+
+	if queryErr != context.Canceled {
+		t.Errorf("expected the query context to be cancelled, got: %v", queryErr)
+	}
+	if !result.IsError {
+		t.Fatal("expected an error result")
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "cancelled by the client") {
+		t.Errorf("expected a cancelled result, got %q", text)
+	}
+}
+
+func TestCancellationOfOtherRequests(t *testing.T) {
+	s := NewNeo4jMCPServer("test-version", &config.Config{}, nil, nil)
+	s.MCPServer.AddTool(mcp.NewTool("test-tool"), func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Cancelling requests that are not running must not affect this one
+		cancelRequest(s, "other")
+		cancelRequest(s, nil)
+		if err := ctx.Err(); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	if result := callTool(t, s, "1", "test-tool", nil); result.IsError {
+		t.Errorf("expected a successful result, got %+v", result)
+	}
+}
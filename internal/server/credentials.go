@@ -0,0 +1,62 @@
+package server
+
+import (
+	"encoding/base64"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// neo4jAuthorizationHeader carries the Neo4j credentials when the Authorization header is used to authenticate with the MCP server
+const neo4jAuthorizationHeader = "X-Neo4j-Authorization"
+
+// neo4jCredentialsMiddleware requires every HTTP request to carry Neo4j credentials in the given header,
+// using the same syntax as the Authorization header. The credentials are attached to the request context so that tool calls run as the authenticated Neo4j user,
+// which lets Neo4j enforce its role-based access control per end user instead of per server process.
+func neo4jCredentialsMiddleware(header string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, err := authTokenFromHeader(r.Header.Get(header))
+		if err != nil {
+			log.Printf("Rejected HTTP request without valid Neo4j credentials: %v", err)
+			w.Header().Set("WWW-Authenticate", `Basic realm="neo4j-mcp", Bearer realm="neo4j-mcp"`)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(database.WithAuthToken(r.Context(), token)))
+	})
+}
+
+// authTokenFromHeader converts an HTTP Authorization header value into a Neo4j auth token.
+// Both the Basic (username and password) and Bearer (SSO token) schemes are supported.
+func authTokenFromHeader(header string) (neo4j.AuthToken, error) {
+	if header == "" {
+		return neo4j.AuthToken{}, errors.New("missing Neo4j credentials")
+	}
+
+	scheme, credentials, found := strings.Cut(header, " ")
+	credentials = strings.TrimSpace(credentials)
+	if !found || credentials == "" {
+		return neo4j.AuthToken{}, errors.New("malformed Neo4j credentials")
+	}
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		decoded, err := base64.StdEncoding.DecodeString(credentials)
+		if err != nil {
+			return neo4j.AuthToken{}, errors.New("malformed Basic credentials")
+		}
+		username, password, found := strings.Cut(string(decoded), ":")
+		if !found || username == "" {
+			return neo4j.AuthToken{}, errors.New("malformed Basic credentials")
+		}
+		return neo4j.BasicAuth(username, password, ""), nil
+	case "bearer":
+		return neo4j.BearerAuth(credentials), nil
+	default:
+		return neo4j.AuthToken{}, errors.New("unsupported Authorization scheme, use Basic or Bearer")
+	}
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestAuthTokenFromHeader(t *testing.T) {
+	basic := "Basic " + base64.StdEncoding.EncodeToString([]byte("alice:s3cret:with:colons"))
+
+	tests := []struct {
+		name    string
+		header  string
+		want    neo4j.AuthToken
+		wantErr bool
+	}{
+		{
+			name:   "basic credentials",
+			header: basic,
+			want:   neo4j.BasicAuth("alice", "s3cret:with:colons", ""),
+		},
+		{
+			name:   "bearer token",
+			header: "Bearer abc.def.ghi",
+			want:   neo4j.BearerAuth("abc.def.ghi"),
+		},
+		{
+			name:   "scheme is case insensitive",
+			header: "bearer abc",
+			want:   neo4j.BearerAuth("abc"),
+		},
+		{
+			name:    "missing header",
+			header:  "",
+			wantErr: true,
+		},
+		{
+			name:    "missing credentials",
+			header:  "Bearer ",
+			wantErr: true,
+		},
+		{
+			name:    "invalid base64",
+			header:  "Basic not-base64!",
+			wantErr: true,
+		},
+		{
+			name:    "basic credentials without password separator",
+			header:  "Basic " + base64.StdEncoding.EncodeToString([]byte("alice")),
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			header:  "Digest abc",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := authTokenFromHeader(tt.header)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("authTokenFromHeader() expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("authTokenFromHeader() unexpected error = %v", err)
+			}
+			for key, value := range tt.want.Tokens {
+				if got.Tokens[key] != value {
+					t.Errorf("authTokenFromHeader() token[%s] = %v, want %v", key, got.Tokens[key], value)
+				}
+			}
+		})
+	}
+}
+
+func TestNeo4jCredentialsMiddleware(t *testing.T) {
+	var gotToken neo4j.AuthToken
+	var called bool
+	handler := neo4jCredentialsMiddleware("Authorization", http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		called = true
+		gotToken, _ = database.AuthTokenFromContext(r.Context())
+	}))
+
+	t.Run("rejects requests without credentials", func(t *testing.T) {
+		called = false
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/mcp", nil))
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("expected status 401, got %d", rec.Code)
+		}
+		if called {
+			t.Error("expected next handler not to be called")
+		}
+	})
+
+	t.Run("attaches credentials to the request context", func(t *testing.T) {
+		called = false
+		req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+		req.Header.Set("Authorization", "Bearer abc")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if !called {
+			t.Fatal("expected next handler to be called")
+		}
+		if gotToken.Tokens["credentials"] != "abc" {
+			t.Errorf("expected bearer token in context, got %v", gotToken.Tokens)
+		}
+	})
+}
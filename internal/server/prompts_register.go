@@ -0,0 +1,63 @@
+package server
+
+import (
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/prompts"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+// serverPrompt is a prompt with its handler, telling whether its workflow only reads the database
+type serverPrompt struct {
+	server.ServerPrompt
+	readOnly bool
+}
+
+// RegisterPrompts registers all enabled MCP prompts and adds them to the provided MCP server.
+// When the read-only mode is enabled, prompts whose workflow modifies the database are excluded.
+// The MCP server has no prompt handler middleware, the handlers are wrapped here to read the schema
+// with the same authentication and transaction metadata as tools.
+func (s *Neo4jMCPServer) RegisterPrompts() {
+	readOnly := s.config != nil && s.config.ReadOnly
+	authRequired := s.config != nil && s.config.HTTPAuthEnabled()
+
+	enabled := make([]server.ServerPrompt, 0)
+	for _, p := range getAllPrompts(s.deps) {
+		if readOnly && !p.readOnly {
+			continue
+		}
+		handler := promptTransactionMiddleware(s.config, p.Handler)
+		if authRequired {
+			handler = requireAuthenticatedPromptGet(handler)
+		}
+		enabled = append(enabled, server.ServerPrompt{Prompt: p.Prompt, Handler: handler})
+	}
+	s.MCPServer.AddPrompts(enabled...)
+}
+
+// getAllPrompts returns all available prompts with their specs and handlers
+func getAllPrompts(deps *tools.ToolDependencies) []serverPrompt {
+	return []serverPrompt{
+		{
+			ServerPrompt: server.ServerPrompt{
+				Prompt:  prompts.ExploreGraphSpec(),
+				Handler: prompts.ExploreGraphHandler(deps),
+			},
+			readOnly: true,
+		},
+		{
+			ServerPrompt: server.ServerPrompt{
+				Prompt:  prompts.CypherQuerySpec(),
+				Handler: prompts.CypherQueryHandler(deps),
+			},
+			readOnly: true,
+		},
+		// GDS Category/Section
+		{
+			ServerPrompt: server.ServerPrompt{
+				Prompt:  prompts.CommunityDetectionSpec(),
+				Handler: prompts.CommunityDetectionHandler(deps),
+			},
+			readOnly: false,
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPromptRegister(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		readOnly bool
+		want     []string
+	}{
+		{name: "all prompts", readOnly: false, want: []string{"community-detection", "cypher-query", "explore-graph"}},
+		{name: "only read-only prompts when readonly", readOnly: true, want: []string{"cypher-query", "explore-graph"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{URI: "bolt://localhost:7687", Username: "neo4j", Password: "password", Database: "neo4j", ReadOnly: tt.readOnly}
+			s := NewNeo4jMCPServer("test-version", cfg, db.NewMockService(ctrl), analytics.NewMockService(ctrl))
+			s.RegisterPrompts()
+
+			list, ok := request(t, s, "prompts/list", nil).(mcp.ListPromptsResult)
+			if !ok {
+				t.Fatalf("expected a prompts list")
+			}
+			got := make([]string, 0, len(list.Prompts))
+			for _, p := range list.Prompts {
+				got = append(got, p.Name)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected prompts %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("expected prompts %v, got %v", tt.want, got)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestPromptTransactionMiddleware(t *testing.T) {
+	cfg := &config.Config{}
+	var metadata map[string]any
+	handler := promptTransactionMiddleware(cfg, func(ctx context.Context, _ mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		metadata = database.TxMetadataFromContext(ctx)
+		return &mcp.GetPromptResult{}, nil
+	})
+
+	request := mcp.GetPromptRequest{Params: mcp.GetPromptParams{Name: "explore-graph"}}
+	if _, err := handler(context.Background(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if metadata["prompt"] != "explore-graph" || metadata["app"] != txMetadataApp {
+		t.Errorf("expected transaction metadata identifying the prompt, got %v", metadata)
+	}
+}
+
+func TestRequireAuthenticatedPromptGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := db.NewMockService(ctrl)
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*neo4j.Record{}, nil).Times(3)
+
+	cfg := &config.Config{URI: "bolt://localhost:7687", Username: "neo4j", Password: "password", Database: "neo4j", Transport: config.TransportHTTP, HTTPAuth: config.HTTPAuthAPIKey}
+	s := NewNeo4jMCPServer("test-version", cfg, mockDB, analytics.NewMockService(ctrl))
+	s.RegisterPrompts()
+
+	message := []byte(`{"jsonrpc":"2.0","id":1,"method":"prompts/get","params":{"name":"explore-graph"}}`)
+	if _, ok := s.MCPServer.HandleMessage(context.Background(), message).(mcp.JSONRPCError); !ok {
+		t.Errorf("expected unauthenticated prompt requests to be rejected")
+	}
+
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice", Method: config.HTTPAuthAPIKey})
+	if _, ok := s.MCPServer.HandleMessage(ctx, message).(mcp.JSONRPCResponse); !ok {
+		t.Errorf("expected authenticated prompt requests to succeed")
+	}
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/resources"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+// RegisterResources registers the MCP resources exposing the graph schema:
+// the schema of the default database, and templates for the schema of any allowed database and of each label.
+func (s *Neo4jMCPServer) RegisterResources() {
+	if s.config != nil && s.config.Database != "" {
+		s.MCPServer.AddResource(resources.SchemaSpec(s.config.Database), resources.SchemaHandler(s.deps))
+	}
+	s.MCPServer.AddResourceTemplates(getAllResourceTemplates(s.deps)...)
+}
+
+// getAllResourceTemplates returns all available resource templates with their handlers
+func getAllResourceTemplates(deps *tools.ToolDependencies) []server.ServerResourceTemplate {
+	return []server.ServerResourceTemplate{
+		{
+			Template: resources.SchemaTemplateSpec(),
+			Handler:  resources.SchemaHandler(deps),
+		},
+		{
+			Template: resources.LabelTemplateSpec(),
+			Handler:  resources.LabelHandler(deps),
+		},
+	}
+}
+
+// notifySchemaUpdated tells every client that the schema resource of the database changed. Only the URI of the schema
+// is sent, not the ones of its labels, so that no label read with one user's credentials reaches the clients of other users.
+func notifySchemaUpdated(mcpServer *server.MCPServer, database string) {
+	mcpServer.SendNotificationToAllClients(mcp.MethodNotificationResourceUpdated, map[string]any{"uri": resources.SchemaURI(database)})
+}
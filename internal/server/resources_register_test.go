@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+// notifiedSession is an initialized client session recording the notifications sent to it
+type notifiedSession struct {
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *notifiedSession) SessionID() string { return "notified-session" }
+func (s *notifiedSession) Initialize()       {}
+func (s *notifiedSession) Initialized() bool { return true }
+func (s *notifiedSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return s.notifications
+}
+
+// request sends a JSON-RPC request to s and returns its result
+func request(t *testing.T, s *Neo4jMCPServer, method string, params map[string]any) any {
+	t.Helper()
+	message, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	if err != nil {
+		t.Fatalf("failed to marshal request: %v", err)
+	}
+	response, ok := s.MCPServer.HandleMessage(context.Background(), message).(mcp.JSONRPCResponse)
+	if !ok {
+		t.Fatalf("expected a JSON-RPC response to %s", method)
+	}
+	return response.Result
+}
+
+func TestResourceRegister(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{URI: "bolt://localhost:7687", Username: "neo4j", Password: "password", Database: "movies"}
+	s := NewNeo4jMCPServer("test-version", cfg, db.NewMockService(ctrl), analytics.NewMockService(ctrl))
+	s.RegisterResources()
+
+	list, ok := request(t, s, "resources/list", nil).(mcp.ListResourcesResult)
+	if !ok || len(list.Resources) != 1 || list.Resources[0].URI != "neo4j://movies/schema" {
+		t.Errorf("expected the schema resource of the default database, got %+v", list)
+	}
+
+	templates, ok := request(t, s, "resources/templates/list", nil).(mcp.ListResourceTemplatesResult)
+	if !ok || len(templates.ResourceTemplates) != 2 {
+		t.Errorf("expected 2 resource templates, got %+v", templates)
+	}
+}
+
+func TestSchemaResourceUpdates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent(gomock.Any()).AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+
+	apocValue := map[string]any{"Person": map[string]any{"type": "node", "count": int64(1)}}
+	mockDB := db.NewMockService(ctrl)
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]*neo4j.Record{{Keys: []string{"value"}, Values: []any{apocValue}}}, nil)
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*neo4j.Record{}, nil).Times(2)
+	mockDB.EXPECT().ExecuteWriteQueryWithCounters(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&database.WriteResult{Counters: database.Counters{NodesCreated: 1, LabelsAdded: 1}}, nil)
+
+	cfg := &config.Config{URI: "bolt://localhost:7687", Username: "neo4j", Password: "password", Database: "neo4j", SchemaCacheTTL: time.Minute}
+	s := NewNeo4jMCPServer("test-version", cfg, mockDB, analyticsService)
+	if err := s.RegisterTools(); err != nil {
+		t.Fatalf("RegisterTools() failed: %v", err)
+	}
+	s.RegisterResources()
+
+	session := &notifiedSession{notifications: make(chan mcp.JSONRPCNotification, 10)}
+	if err := s.MCPServer.RegisterSession(context.Background(), session); err != nil {
+		t.Fatalf("RegisterSession() failed: %v", err)
+	}
+
+	read, ok := request(t, s, "resources/read", map[string]any{"uri": "neo4j://neo4j/labels/Person"}).(mcp.ReadResourceResult)
+	if !ok || len(read.Contents) != 1 {
+		t.Fatalf("expected the contents of the label resource, got %+v", read)
+	}
+
+	if result := callTool(t, s, "write", "write-cypher", map[string]any{"query": "CREATE (:Company)"}); result.IsError {
+		t.Fatalf("expected write-cypher to succeed, got %+v", result)
+	}
+
+	select {
+	case notification := <-session.notifications:
+		if notification.Method != mcp.MethodNotificationResourceUpdated || notification.Params.AdditionalFields["uri"] != "neo4j://neo4j/schema" {
+			t.Errorf("expected a resource updated notification for the schema only, got %+v", notification)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a resource updated notification")
+	}
+	select {
+	case notification := <-session.notifications:
+		t.Errorf("expected a single notification, got %+v", notification)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
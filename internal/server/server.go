@@ -1,13 +1,30 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/neo4j/mcp/internal/analytics"
 	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/cursor"
 	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/schemacache"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+const (
+	// httpReadHeaderTimeout bounds how long a client may take to send request headers
+	httpReadHeaderTimeout = 10 * time.Second
+	// httpShutdownTimeout bounds how long Stop waits for in-flight HTTP requests to complete
+	httpShutdownTimeout = 10 * time.Second
 )
 
 // Neo4jMCPServer represents the MCP server instance
@@ -17,18 +34,56 @@ type Neo4jMCPServer struct {
 	dbService database.Service
 	version   string
 	anService analytics.Service
+	deps      *tools.ToolDependencies // shared by the tools and resources
+
+	mu         sync.Mutex
+	httpServer *http.Server
+	stopped    bool
 }
 
 // NewNeo4jMCPServer creates a new MCP server instance
 // The config parameter is expected to be already validated
 func NewNeo4jMCPServer(version string, cfg *config.Config, dbService database.Service, anService analytics.Service) *Neo4jMCPServer {
-	mcpServer := server.NewMCPServer(
-		"neo4j-mcp",
-		version,
+	opts := []server.ServerOption{
 		server.WithToolCapabilities(true),
-		server.WithInstructions("This is the Neo4j official MCP server and can provide tool calling to interact with your Neo4j database,"+
+		// resources/subscribe is not supported, schema changes are notified to every client as resource updates
+		server.WithResourceCapabilities(false, false),
+		server.WithPromptCapabilities(false),
+		server.WithInstructions("This is the Neo4j official MCP server and can provide tool calling to interact with your Neo4j database," +
 			"by inferring the schema with tools like get-schema and executing arbitrary Cypher queries with read-cypher."),
+	}
+	hooks := &server.Hooks{}
+	hooks.AddBeforeCallTool(stampRequestID)
+	calls := newCancellations()
+	opts = append(opts,
+		server.WithHooks(hooks),
+		server.WithToolHandlerMiddleware(calls.middleware),
+		server.WithToolHandlerMiddleware(transactionMiddleware(cfg)),
+		server.WithResourceHandlerMiddleware(resourceTransactionMiddleware(cfg)),
 	)
+	if cfg != nil && cfg.HTTPAuthEnabled() {
+		opts = append(opts,
+			server.WithToolHandlerMiddleware(requireAuthenticatedToolCall),
+			server.WithResourceHandlerMiddleware(requireAuthenticatedResourceRead),
+		)
+	}
+	mcpServer := server.NewMCPServer("neo4j-mcp", version, opts...)
+	mcpServer.AddNotificationHandler(cancelledNotificationMethod, calls.handleNotification)
+
+	deps := &tools.ToolDependencies{
+		DBService:        dbService,
+		AnalyticsService: anService,
+		Config:           cfg,
+	}
+	if cfg != nil && cfg.CursorTTL > 0 {
+		deps.Cursors = cursor.NewStore(cfg.CursorTTL, cfg.MaxCursorsPerSession)
+	}
+	if cfg != nil && cfg.SchemaCacheTTL > 0 {
+		deps.SchemaCache = schemacache.New(cfg.SchemaCacheTTL)
+		deps.SchemaCache.OnInvalidate(func(database string) {
+			notifySchemaUpdated(mcpServer, database)
+		})
+	}
 
 	return &Neo4jMCPServer{
 		MCPServer: mcpServer,
@@ -36,10 +91,12 @@ func NewNeo4jMCPServer(version string, cfg *config.Config, dbService database.Se
 		dbService: dbService,
 		version:   version,
 		anService: anService,
+		deps:      deps,
 	}
 }
 
-// Start initializes and starts the MCP server using stdio transport
+// Start initializes and starts the MCP server using the configured transport (stdio by default).
+// It blocks until the transport stops serving.
 func (s *Neo4jMCPServer) Start() error {
 	log.Println("Starting Neo4j MCP Server...")
 
@@ -50,15 +107,85 @@ func (s *Neo4jMCPServer) Start() error {
 	if err := s.RegisterTools(); err != nil {
 		return fmt.Errorf("failed to register tools: %w", err)
 	}
+	s.RegisterResources()
+	s.RegisterPrompts()
+
+	if s.config != nil && s.config.Transport == config.TransportHTTP {
+		return s.serveHTTP()
+	}
+
 	log.Println("Started Neo4j MCP Server. Now listening for input...")
 	// Note: ServeStdio handles its own signal management for graceful shutdown
 	return server.ServeStdio(s.MCPServer)
 }
 
-// Stop gracefully stops the server
+// serveHTTP serves the registered tools over MCP Streamable HTTP until Stop is called
+func (s *Neo4jMCPServer) serveHTTP() error {
+	authenticator, err := newAuthenticator(s.config)
+	if err != nil {
+		return fmt.Errorf("failed to create authenticator: %w", err)
+	}
+
+	addr := net.JoinHostPort(s.config.HTTPHost, strconv.Itoa(s.config.HTTPPort))
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	var handler http.Handler = server.NewStreamableHTTPServer(s.MCPServer)
+	if s.config.HTTPPerRequestAuth {
+		// The Authorization header is taken by the MCP authentication when it is enabled
+		credentialsHeader := "Authorization"
+		if authenticator != nil {
+			credentialsHeader = neo4jAuthorizationHeader
+		}
+		handler = neo4jCredentialsMiddleware(credentialsHeader, handler)
+	}
+	if authenticator != nil {
+		handler = authenticationMiddleware(authenticator, handler)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(s.config.HTTPBasePath, handler)
+
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return listener.Close()
+	}
+	s.httpServer = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: httpReadHeaderTimeout,
+	}
+	httpServer := s.httpServer
+	s.mu.Unlock()
+
+	log.Printf("Started Neo4j MCP Server. Now listening on http://%s%s", listener.Addr(), s.config.HTTPBasePath)
+	if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("HTTP server error: %w", err)
+	}
+	return nil
+}
+
+// Stop gracefully stops the server.
+// When serving over HTTP, it stops accepting new connections and waits for in-flight requests to complete.
 func (s *Neo4jMCPServer) Stop() error {
 	log.Println("Stopping Neo4j MCP Server...")
-	// Currently no cleanup needed - the MCP server handles its own lifecycle
 	// Database service cleanup is handled by the caller (main.go)
+	s.mu.Lock()
+	s.stopped = true
+	httpServer := s.httpServer
+	s.mu.Unlock()
+
+	// The stdio transport handles its own lifecycle
+	if httpServer == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to shut down HTTP server: %w", err)
+	}
 	return nil
 }
@@ -1,7 +1,13 @@
 package server_test
 
 import (
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
 	"github.com/neo4j/mcp/internal/config"
@@ -119,3 +125,113 @@ func TestNewNeo4jMCPServerEvents(t *testing.T) {
 		}
 	})
 }
+
+func TestNeo4jMCPServerHTTPTransport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := db_mock.NewMockService(ctrl)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	analyticsService.EXPECT().NewStartupEvent().AnyTimes()
+
+	t.Run("serves registered tools over streamable HTTP and stops gracefully", func(t *testing.T) {
+		port := freePort(t)
+		cfg := &config.Config{
+			URI:          "bolt://test-host:7687",
+			Username:     "neo4j",
+			Password:     "password",
+			Database:     "neo4j",
+			Transport:    config.TransportHTTP,
+			HTTPHost:     "127.0.0.1",
+			HTTPPort:     port,
+			HTTPBasePath: "/mcp",
+		}
+		s := server.NewNeo4jMCPServer("test-version", cfg, mockDB, analyticsService)
+
+		startErr := make(chan error, 1)
+		go func() {
+			startErr <- s.Start()
+		}()
+
+		endpoint := "http://127.0.0.1:" + strconv.Itoa(port) + "/mcp"
+		initialize := `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18","capabilities":{},"clientInfo":{"name":"test","version":"1.0.0"}}}`
+
+		var resp *http.Response
+		var err error
+		for i := 0; i < 50; i++ {
+			resp, err = http.Post(endpoint, "application/json", strings.NewReader(initialize))
+			if err == nil {
+				break
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		if err != nil {
+			t.Fatalf("initialize request failed: %v", err)
+		}
+		sessionID := resp.Header.Get("Mcp-Session-Id")
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("initialize: expected status 200, got %d", resp.StatusCode)
+		}
+
+		req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`))
+		if err != nil {
+			t.Fatalf("failed to build tools/list request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Mcp-Session-Id", sessionID)
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("tools/list request failed: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !strings.Contains(string(body), `"read-cypher"`) {
+			t.Errorf("tools/list: expected read-cypher in response, got %s", body)
+		}
+
+		if err := s.Stop(); err != nil {
+			t.Errorf("Stop() unexpected error = %v", err)
+		}
+		select {
+		case err := <-startErr:
+			if err != nil {
+				t.Errorf("Start() unexpected error = %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Start() did not return after Stop()")
+		}
+	})
+
+	t.Run("stop before start prevents serving", func(t *testing.T) {
+		cfg := &config.Config{
+			URI:          "bolt://test-host:7687",
+			Username:     "neo4j",
+			Password:     "password",
+			Transport:    config.TransportHTTP,
+			HTTPHost:     "127.0.0.1",
+			HTTPPort:     freePort(t),
+			HTTPBasePath: "/mcp",
+		}
+		s := server.NewNeo4jMCPServer("test-version", cfg, mockDB, analyticsService)
+
+		if err := s.Stop(); err != nil {
+			t.Errorf("Stop() unexpected error = %v", err)
+		}
+		if err := s.Start(); err != nil {
+			t.Errorf("Start() unexpected error = %v", err)
+		}
+	})
+}
+
+// freePort returns a TCP port that is currently free on the loopback interface
+func freePort(t *testing.T) int {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port
+}
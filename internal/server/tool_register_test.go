@@ -30,7 +30,7 @@ func TestToolRegister(t *testing.T) {
 
 		// Expected tools that should be registered
 		// update this number when a tool is added or removed.
-		expectedTotalToolsCount := 4
+		expectedTotalToolsCount := 12
 
 		// Register tools
 		err := s.RegisterTools()
@@ -50,13 +50,13 @@ func TestToolRegister(t *testing.T) {
 			Username: "neo4j",
 			Password: "password",
 			Database: "neo4j",
-			ReadOnly: "true",
+			ReadOnly: true,
 		}
 		s := server.NewNeo4jMCPServer("test-version", cfg, mockDB, analyticsService)
 
 		// Expected tools that should be registered
 		// update this number when a tool is added or removed.
-		expectedTotalToolsCount := 3
+		expectedTotalToolsCount := 11
 
 		// Register tools
 		err := s.RegisterTools()
@@ -75,13 +75,13 @@ func TestToolRegister(t *testing.T) {
 			Username: "neo4j",
 			Password: "password",
 			Database: "neo4j",
-			ReadOnly: "false",
+			ReadOnly: false,
 		}
 		s := server.NewNeo4jMCPServer("test-version", cfg, mockDB, analyticsService)
 
 		// Expected tools that should be registered
 		// update this number when a tool is added or removed.
-		expectedTotalToolsCount := 4
+		expectedTotalToolsCount := 12
 
 		// Register tools
 		err := s.RegisterTools()
@@ -94,4 +94,19 @@ func TestToolRegister(t *testing.T) {
 			t.Errorf("Expected %d tools, but test configuration shows %d", expectedTotalToolsCount, registeredTools)
 		}
 	})
+
+	t.Run("should fail with an unknown embedding provider", func(t *testing.T) {
+		cfg := &config.Config{
+			URI:               "bolt://test-host:7687",
+			Username:          "neo4j",
+			Password:          "password",
+			Database:          "neo4j",
+			EmbeddingProvider: "unknown",
+		}
+		s := server.NewNeo4jMCPServer("test-version", cfg, mockDB, analyticsService)
+
+		if err := s.RegisterTools(); err == nil {
+			t.Error("Expected RegisterTools() to fail with an unknown embedding provider")
+		}
+	})
 }
@@ -1,10 +1,14 @@
 package server
 
 import (
+	"fmt"
+
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/embedding"
 	"github.com/neo4j/mcp/internal/tools"
 	"github.com/neo4j/mcp/internal/tools/cypher"
 	"github.com/neo4j/mcp/internal/tools/gds"
+	"github.com/neo4j/mcp/internal/tools/search"
 )
 
 // RegisterTools registers all enabled MCP tools and adds them to the provided MCP server.
@@ -14,15 +18,23 @@ import (
 // Note: this read-only filtering relies on the tool annotation "readonly" (ReadOnlyHint). If the annotation
 // is not defined or is set to false, the tool will be added (i.e., only tools with readonly=true are filtered in read-only mode).
 func (s *Neo4jMCPServer) RegisterTools() error {
-	deps := &tools.ToolDependencies{
-		DBService:        s.dbService,
-		AnalyticsService: s.anService,
+	deps := s.deps
+	if deps != nil && s.config != nil && s.config.EmbeddingProvider != "" {
+		embedder, err := embedding.New(s.config.EmbeddingProvider, embedding.Options{
+			URL:   s.config.EmbeddingURL,
+			Model: s.config.EmbeddingModel,
+			// a hanging embedding service must not block a search longer than its query may run
+			Timeout: s.config.QueryTimeout,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create embedding provider: %w", err)
+		}
+		deps.Embedder = embedder
 	}
-
 	all := getAllTools(deps)
 
 	// If read-only mode is enabled, expose only tools annotated as read-only.
-	if deps != nil && s.config != nil && s.config.ReadOnly == "true" {
+	if deps != nil && s.config != nil && s.config.ReadOnly {
 		readOnlyTools := make([]server.ServerTool, 0, len(all))
 		for _, t := range all {
 			if t.Tool.Annotations.ReadOnlyHint != nil && *t.Tool.Annotations.ReadOnlyHint {
@@ -48,15 +60,48 @@ func getAllTools(deps *tools.ToolDependencies) []server.ServerTool {
 			Tool:    cypher.ReadCypherSpec(),
 			Handler: cypher.ReadCypherHandler(deps),
 		},
+		{
+			Tool:    cypher.ExplainCypherSpec(),
+			Handler: cypher.ExplainCypherHandler(deps),
+		},
+		{
+			Tool:    cypher.ProfileCypherSpec(),
+			Handler: cypher.ProfileCypherHandler(deps),
+		},
+		{
+			Tool:    cypher.FetchMoreSpec(),
+			Handler: cypher.FetchMoreHandler(deps),
+		},
 		{
 			Tool:    cypher.WriteCypherSpec(),
 			Handler: cypher.WriteCypherHandler(deps),
 		},
+		{
+			Tool:    cypher.ListDatabasesSpec(),
+			Handler: cypher.ListDatabasesHandler(deps),
+		},
 		// GDS Category/Section
 		{
 			Tool:    gds.ListGDSProceduresSpec(),
 			Handler: gds.ListGdsProceduresHandler(deps),
 		},
+		// Search Category/Section
+		{
+			Tool:    search.ListVectorIndexesSpec(),
+			Handler: search.ListVectorIndexesHandler(deps),
+		},
+		{
+			Tool:    search.VectorSearchSpec(),
+			Handler: search.VectorSearchHandler(deps),
+		},
+		{
+			Tool:    search.ListFulltextIndexesSpec(),
+			Handler: search.ListFulltextIndexesHandler(deps),
+		},
+		{
+			Tool:    search.FulltextSearchSpec(),
+			Handler: search.FulltextSearchHandler(deps),
+		},
 		// Add other categories below...
 	}
 }
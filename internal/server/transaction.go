@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+)
+
+const (
+	// requestIDMetaKey is the _meta field of tool calls the JSON-RPC request ID is copied to, as tool handlers do not receive it
+	requestIDMetaKey = "neo4j-mcp/requestId"
+	// txMetadataApp identifies the transactions of this server in their metadata
+	txMetadataApp = "neo4j-mcp"
+)
+
+// stampRequestID copies the JSON-RPC ID of a tool call into its _meta, before the request is passed to the tool handler
+func stampRequestID(_ context.Context, id any, request *mcp.CallToolRequest) {
+	if request.Params.Meta == nil {
+		request.Params.Meta = &mcp.Meta{}
+	}
+	if request.Params.Meta.AdditionalFields == nil {
+		request.Params.Meta.AdditionalFields = make(map[string]any)
+	}
+	if rid, ok := id.(mcp.RequestId); ok {
+		id = rid.Value()
+	}
+	request.Params.Meta.AdditionalFields[requestIDMetaKey] = fmt.Sprint(id)
+}
+
+// requestID returns the JSON-RPC ID of a tool call stamped by stampRequestID, or an empty string
+func requestID(request mcp.CallToolRequest) string {
+	if request.Params.Meta == nil {
+		return ""
+	}
+	id, _ := request.Params.Meta.AdditionalFields[requestIDMetaKey].(string)
+	return id
+}
+
+// clientName returns the name the MCP client gave when initializing its session, or an empty string
+func clientName(ctx context.Context) string {
+	if session, ok := server.ClientSessionFromContext(ctx).(server.SessionWithClientInfo); ok {
+		return session.GetClientInfo().Name
+	}
+	return ""
+}
+
+// transactionMiddleware applies the default query timeout to tool calls and tags their transactions with metadata
+// identifying the tool, the client and the request, so they can be found with SHOW TRANSACTIONS.
+func transactionMiddleware(cfg *config.Config) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ctx = transactionContext(ctx, cfg, map[string]any{
+				"tool":      request.Params.Name,
+				"requestId": requestID(request),
+			})
+			return next(ctx, request)
+		}
+	}
+}
+
+// resourceTransactionMiddleware applies the default query timeout to resource reads and tags their transactions with metadata
+// identifying the resource and the client
+func resourceTransactionMiddleware(cfg *config.Config) server.ResourceHandlerMiddleware {
+	return func(next server.ResourceHandlerFunc) server.ResourceHandlerFunc {
+		return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			ctx = transactionContext(ctx, cfg, map[string]any{"resource": request.Params.URI})
+			return next(ctx, request)
+		}
+	}
+}
+
+// promptTransactionMiddleware applies the default query timeout to prompt requests and tags their transactions with metadata
+// identifying the prompt and the client
+func promptTransactionMiddleware(cfg *config.Config, next server.PromptHandlerFunc) server.PromptHandlerFunc {
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		ctx = transactionContext(ctx, cfg, map[string]any{"prompt": request.Params.Name})
+		return next(ctx, request)
+	}
+}
+
+// transactionContext returns a copy of ctx applying the default query timeout and tagging transactions with metadata,
+// completed with the application, the client and the authenticated principal
+func transactionContext(ctx context.Context, cfg *config.Config, metadata map[string]any) context.Context {
+	metadata["app"] = txMetadataApp
+	metadata["client"] = clientName(ctx)
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		metadata["principal"] = principal.Subject
+	}
+	ctx = database.WithTxMetadata(ctx, metadata)
+
+	if cfg != nil && cfg.QueryTimeout > 0 {
+		ctx = database.WithTxTimeout(ctx, cfg.QueryTimeout)
+	}
+	return ctx
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+)
+
+func TestTransactionMiddleware(t *testing.T) {
+	var gotCtx context.Context
+	handler := transactionMiddleware(&config.Config{QueryTimeout: 30 * time.Second})(
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			gotCtx = ctx
+			return mcp.NewToolResultText("ok"), nil
+		})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "read-cypher"
+	stampRequestID(context.Background(), mcp.NewRequestId(int64(42)), &request)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice"})
+
+	if _, err := handler(ctx, request); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	want := map[string]any{"app": "neo4j-mcp", "tool": "read-cypher", "client": "", "requestId": "42", "principal": "alice"}
+	if got := database.TxMetadataFromContext(gotCtx); !reflect.DeepEqual(got, want) {
+		t.Errorf("metadata = %v, want %v", got, want)
+	}
+	if timeout, ok := database.TxTimeoutFromContext(gotCtx); !ok || timeout != 30*time.Second {
+		t.Errorf("timeout = %s, %v, want 30s", timeout, ok)
+	}
+}
+
+func TestTransactionMiddlewareWithoutDefaultTimeout(t *testing.T) {
+	handler := transactionMiddleware(&config.Config{})(
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if _, ok := database.TxTimeoutFromContext(ctx); ok {
+				t.Error("expected no timeout")
+			}
+			return mcp.NewToolResultText("ok"), nil
+		})
+
+	if _, err := handler(context.Background(), mcp.CallToolRequest{}); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+}
+
+func TestToolCallTransactionMetadata(t *testing.T) {
+	s := NewNeo4jMCPServer("test-version", &config.Config{}, nil, nil)
+
+	var metadata map[string]any
+	s.MCPServer.AddTool(mcp.NewTool("test-tool"), func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		metadata = database.TxMetadataFromContext(ctx)
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	message := json.RawMessage(`{"jsonrpc":"2.0","id":"call-7","method":"tools/call","params":{"name":"test-tool","arguments":{}}}`)
+	s.MCPServer.HandleMessage(context.Background(), message)
+
+	if metadata["tool"] != "test-tool" || metadata["requestId"] != "call-7" {
+		t.Errorf("metadata = %v, want the tool name and request ID", metadata)
+	}
+}
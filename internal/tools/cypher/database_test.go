@@ -0,0 +1,172 @@
+package cypher_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+// targetsDatabase matches contexts targeting the named database, or no database when name is empty
+func targetsDatabase(name string) gomock.Matcher {
+	return gomock.Cond(func(ctx context.Context) bool {
+		got, ok := database.DatabaseFromContext(ctx)
+		return got == name && ok == (name != "")
+	})
+}
+
+// hasReadOptions matches read options equal to want, whatever their record size function
+func hasReadOptions(want database.ReadOptions) gomock.Matcher {
+	return gomock.Cond(func(opts database.ReadOptions) bool {
+		opts.RecordSize = nil
+		return reflect.DeepEqual(opts, want)
+	})
+}
+
+func TestDatabaseArgument(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent(gomock.Any()).AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Database: "neo4j", AllowedDatabases: []string{"movies"}}
+	query := "MATCH (n) RETURN n"
+
+	tests := []struct {
+		name     string
+		database string
+		allowed  bool
+	}{
+		{name: "no database argument", database: "", allowed: true},
+		{name: "default database", database: "neo4j", allowed: true},
+		{name: "allowed database", database: "movies", allowed: true},
+		{name: "database not allowed", database: "payroll", allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run("read-cypher "+tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			if tt.allowed {
+				mockDB.EXPECT().GetQueryType(targetsDatabase(tt.database), query, gomock.Nil()).Return(neo4j.StatementTypeReadOnly, nil)
+				mockDB.EXPECT().ExecuteReadQueryWithOptions(targetsDatabase(tt.database), query, gomock.Nil(), gomock.Any()).Return(&database.ReadResult{}, nil)
+			}
+			deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg}
+
+			result, err := cypher.ReadCypherHandler(deps)(context.Background(), databaseRequest(query, tt.database))
+			checkDatabaseResult(t, result, err, tt.allowed)
+		})
+
+		t.Run("write-cypher "+tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			if tt.allowed {
+				mockDB.EXPECT().ExecuteWriteQueryWithCounters(targetsDatabase(tt.database), query, gomock.Nil()).Return(&database.WriteResult{}, nil)
+			}
+			deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg}
+
+			result, err := cypher.WriteCypherHandler(deps)(context.Background(), databaseRequest(query, tt.database))
+			checkDatabaseResult(t, result, err, tt.allowed)
+		})
+
+		t.Run("explain-cypher "+tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			if tt.allowed {
+				mockDB.EXPECT().ExplainQuery(targetsDatabase(tt.database), query, gomock.Nil()).Return(&database.ExplainResult{}, nil)
+			}
+			deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg}
+
+			result, err := cypher.ExplainCypherHandler(deps)(context.Background(), databaseRequest(query, tt.database))
+			checkDatabaseResult(t, result, err, tt.allowed)
+		})
+
+		t.Run("get-schema "+tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			if tt.allowed {
+				mockDB.EXPECT().ExecuteReadQuery(targetsDatabase(tt.database), gomock.Any(), gomock.Any()).Return([]*neo4j.Record{}, nil).Times(3)
+			}
+			deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg}
+
+			result, err := cypher.GetSchemaHandler(deps)(context.Background(), databaseRequest("", tt.database))
+			checkDatabaseResult(t, result, err, tt.allowed)
+		})
+	}
+}
+
+func databaseRequest(query, database string) mcp.CallToolRequest {
+	arguments := map[string]any{}
+	if query != "" {
+		arguments["query"] = query
+	}
+	if database != "" {
+		arguments["database"] = database
+	}
+	return mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}}
+}
+
+func checkDatabaseResult(t *testing.T, result *mcp.CallToolResult, err error, allowed bool) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result == nil || result.IsError == allowed {
+		t.Errorf("Expected error result: %v, got: %+v", !allowed, result)
+	}
+}
+
+func TestTimeoutArgument(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent(gomock.Any()).AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Database: "neo4j", QueryTimeout: 30 * time.Second, MaxQueryTimeout: time.Minute}
+	query := "MATCH (n) DETACH DELETE n"
+
+	tests := []struct {
+		name        string
+		timeout     any
+		wantTimeout time.Duration // 0 when the context must not carry a timeout
+		allowed     bool
+	}{
+		{name: "no timeout argument", allowed: true},
+		{name: "timeout in seconds", timeout: 10, wantTimeout: 10 * time.Second, allowed: true},
+		{name: "fractional timeout", timeout: 1.5, wantTimeout: 1500 * time.Millisecond, allowed: true},
+		{name: "maximum timeout", timeout: 60, wantTimeout: time.Minute, allowed: true},
+		{name: "timeout above the maximum", timeout: 61, allowed: false},
+		{name: "negative timeout", timeout: -1, allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			if tt.allowed {
+				hasTimeout := gomock.Cond(func(ctx context.Context) bool {
+					timeout, ok := database.TxTimeoutFromContext(ctx)
+					return timeout == tt.wantTimeout && ok == (tt.wantTimeout != 0)
+				})
+				mockDB.EXPECT().ExecuteWriteQueryWithCounters(hasTimeout, query, gomock.Nil()).Return(&database.WriteResult{}, nil)
+			}
+			deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg}
+
+			arguments := map[string]any{"query": query}
+			if tt.timeout != nil {
+				arguments["timeout"] = tt.timeout
+			}
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}}
+
+			result, err := cypher.WriteCypherHandler(deps)(context.Background(), request)
+			checkDatabaseResult(t, result, err, tt.allowed)
+		})
+	}
+}
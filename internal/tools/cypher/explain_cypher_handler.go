@@ -0,0 +1,113 @@
+package cypher
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+// ExplainCypherHandler returns a handler function for the explain-cypher tool
+func ExplainCypherHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleExplainCypher(ctx, request, deps.DBService, deps.AnalyticsService, deps.Config)
+	}
+}
+
+// handleExplainCypher returns the plan of a query as structured content with a compact text rendering
+func handleExplainCypher(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if asService == nil {
+		errMessage := "Analytics service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if dbService == nil {
+		errMessage := "Database service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	asService.EmitEvent(asService.NewToolsEvent("explain-cypher"))
+	var args ExplainCypherInput
+	// Use our custom BindArguments that preserves integer types
+	if err := BindArguments(request, &args); err != nil {
+		log.Printf("Error binding arguments: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if args.Query == "" {
+		errMessage := "Query parameter is required and cannot be empty"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	ctx, err := tools.DatabaseContext(ctx, cfg, args.Database)
+	if err != nil {
+		log.Printf("Rejected database %q: %v", args.Database, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	result, err := dbService.ExplainQuery(ctx, args.Query, args.Params)
+	if err != nil {
+		log.Printf("Error explaining Cypher query: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultStructured(result, explainText(result)), nil
+}
+
+// explainText renders an explained query as an indented operator tree followed by the notifications, e.g.
+//
+//	Statement type: READ_ONLY
+//	Plan:
+//	ProduceResults@neo4j (estimated rows: 10) n
+//	  AllNodesScan@neo4j (estimated rows: 10) n
+//	Notifications:
+//	WARNING Neo.ClientNotification.Statement.CartesianProduct: This query builds a cartesian product ...
+func explainText(result *database.ExplainResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Statement type: %s\n", result.StatementType)
+	if result.Plan != nil {
+		b.WriteString("Plan:\n")
+		writePlan(&b, result.Plan, 0)
+	}
+	writeNotifications(&b, result.Notifications)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// writePlan writes an operator and its children, indented by depth
+func writePlan(b *strings.Builder, plan *database.Plan, depth int) {
+	fmt.Fprintf(b, "%s%s (estimated rows: %g)%s\n", strings.Repeat("  ", depth), plan.Operator, plan.EstimatedRows,
+		operatorDetails(plan.Arguments, plan.Identifiers))
+	for _, child := range plan.Children {
+		writePlan(b, child, depth+1)
+	}
+}
+
+// operatorDetails returns the details of an operator, such as its predicates, or its identifiers when it has none,
+// preceded by a space
+func operatorDetails(arguments map[string]any, identifiers []string) string {
+	if details, ok := arguments["Details"].(string); ok && details != "" {
+		return " " + details
+	}
+	if len(identifiers) > 0 {
+		return " " + strings.Join(identifiers, ", ")
+	}
+	return ""
+}
+
+// writeNotifications writes the notifications of the server, one per line
+func writeNotifications(b *strings.Builder, notifications []database.Notification) {
+	if len(notifications) == 0 {
+		return
+	}
+	b.WriteString("Notifications:\n")
+	for _, n := range notifications {
+		fmt.Fprintf(b, "%s %s: %s\n", n.Severity, n.Code, n.Description)
+	}
+}
@@ -0,0 +1,93 @@
+package cypher_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/database"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExplainCypherHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("explain-cypher").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+
+	t.Run("returns the plan and notifications", func(t *testing.T) {
+		explained := &database.ExplainResult{
+			StatementType: "READ_ONLY",
+			Plan: &database.Plan{
+				Operator:      "ProduceResults@neo4j",
+				EstimatedRows: 10,
+				Identifiers:   []string{"a", "b"},
+				Children: []*database.Plan{
+					{Operator: "CartesianProduct@neo4j", EstimatedRows: 10, Identifiers: []string{"a", "b"}},
+				},
+			},
+			Notifications: []database.Notification{
+				{Code: "Neo.ClientNotification.Statement.CartesianProduct", Description: "This query builds a cartesian product.", Severity: "WARNING"},
+			},
+		}
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().ExplainQuery(gomock.Any(), "MATCH (a), (b) RETURN a, b", gomock.Nil()).Return(explained, nil)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService}
+
+		request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": "MATCH (a), (b) RETURN a, b"}}}
+		result, err := cypher.ExplainCypherHandler(deps)(context.Background(), request)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("unexpected error result: %+v", result)
+		}
+		if result.StructuredContent != explained {
+			t.Errorf("expected the explained query as structured content, got %+v", result.StructuredContent)
+		}
+
+		text := result.Content[0].(mcp.TextContent).Text
+		want := "Statement type: READ_ONLY\n" +
+			"Plan:\n" +
+			"ProduceResults@neo4j (estimated rows: 10) a, b\n" +
+			"  CartesianProduct@neo4j (estimated rows: 10) a, b\n" +
+			"Notifications:\n" +
+			"WARNING Neo.ClientNotification.Statement.CartesianProduct: This query builds a cartesian product."
+		if text != want {
+			t.Errorf("expected text:\n%s\ngot:\n%s", want, text)
+		}
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		deps := &tools.ToolDependencies{DBService: db.NewMockService(ctrl), AnalyticsService: analyticsService}
+
+		request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": ""}}}
+		result, err := cypher.ExplainCypherHandler(deps)(context.Background(), request)
+		if err != nil || !result.IsError {
+			t.Errorf("expected an error result, got %+v, %v", result, err)
+		}
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().ExplainQuery(gomock.Any(), "MATCH (n RETURN n", gomock.Nil()).Return(nil, errors.New("Invalid input"))
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService}
+
+		request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": "MATCH (n RETURN n"}}}
+		result, err := cypher.ExplainCypherHandler(deps)(context.Background(), request)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "Invalid input") {
+			t.Errorf("expected the error of the server, got %+v", result)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package cypher
+
+import (
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type ExplainCypherInput struct {
+	Query    string         `json:"query" jsonschema:"default=MATCH(n) RETURN n,description=The Cypher query to explain, without EXPLAIN or PROFILE"`
+	Params   map[string]any `json:"params" jsonschema:"default={},description=Parameters to pass to the Cypher query"`
+	Database string         `json:"database,omitempty" jsonschema:"description=Name of the database to explain the query against, defaults to the configured database. Use list-databases to find the available databases"`
+}
+
+// GetParams returns the params map
+func (r *ExplainCypherInput) GetParams() map[string]any {
+	return r.Params
+}
+
+// SetParams sets the params map
+func (r *ExplainCypherInput) SetParams(params map[string]any) {
+	r.Params = params
+}
+
+// explainOutputSchema describes the output of explain-cypher.
+// It is written by hand because plans are recursive, which the schema generated from Go types does not support.
+const explainOutputSchema = `{
+  "type": "object",
+  "properties": {
+    "statementType": {"type": "string", "enum": ["READ_ONLY", "READ_WRITE", "WRITE_ONLY", "SCHEMA_WRITE", "UNKNOWN"]},
+    "plan": {"$ref": "#/$defs/plan"},
+    "notifications": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "code": {"type": "string"},
+          "title": {"type": "string"},
+          "description": {"type": "string"},
+          "severity": {"type": "string"},
+          "category": {"type": "string"},
+          "position": {
+            "type": "object",
+            "properties": {"offset": {"type": "integer"}, "line": {"type": "integer"}, "column": {"type": "integer"}}
+          }
+        },
+        "required": ["code", "title", "description", "severity"]
+      }
+    }
+  },
+  "required": ["statementType", "notifications"],
+  "$defs": {
+    "plan": {
+      "type": "object",
+      "properties": {
+        "operator": {"type": "string"},
+        "estimatedRows": {"type": "number"},
+        "identifiers": {"type": "array", "items": {"type": "string"}},
+        "arguments": {"type": "object"},
+        "children": {"type": "array", "items": {"$ref": "#/$defs/plan"}}
+      },
+      "required": ["operator", "estimatedRows", "identifiers"]
+    }
+  }
+}`
+
+func ExplainCypherSpec() mcp.Tool {
+	return mcp.NewTool("explain-cypher",
+		mcp.WithDescription("explain-cypher returns the execution plan of a Cypher statement without running it: "+
+			"the tree of operators with their estimated rows, identifiers and arguments, the statement type, "+
+			"and the warnings of the server such as cartesian products, missing indexes or deprecated syntax. "+
+			"Use it to check that a query is valid and not too expensive before running it with read-cypher or write-cypher. "+
+			"Write statements are explained too, they are never executed."),
+		mcp.WithInputSchema[ExplainCypherInput](),
+		mcp.WithRawOutputSchema(json.RawMessage(explainOutputSchema)),
+		mcp.WithTitleAnnotation("Explain Cypher"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,114 @@
+package cypher
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/cursor"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+// FetchMoreHandler returns a handler function for the fetch-more tool
+func FetchMoreHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleFetchMore(ctx, request, deps.DBService, deps.AnalyticsService, deps.Config, deps.Cursors)
+	}
+}
+
+// handleFetchMore returns the next page of the result of a cursor, re-running its query past the rows already returned
+func handleFetchMore(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service, cfg *config.Config, cursors *cursor.Store) (*mcp.CallToolResult, error) {
+	if asService == nil {
+		errMessage := "Analytics service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if dbService == nil {
+		errMessage := "Database service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if cursors == nil {
+		errMessage := "Pagination is disabled on this server, refine the query to retrieve fewer rows"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	asService.EmitEvent(asService.NewToolsEvent("fetch-more"))
+	var args FetchMoreInput
+	if err := request.BindArguments(&args); err != nil {
+		log.Printf("Error binding arguments: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if args.Cursor == "" {
+		errMessage := "Cursor parameter is required and cannot be empty"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	sessionID := tools.SessionID(ctx)
+	page, err := cursors.Get(sessionID, args.Cursor)
+	if err != nil {
+		log.Printf("Error fetching cursor %q: %v", args.Cursor, err)
+		return mcp.NewToolResultError(fmt.Sprintf("cursor %q is unknown or expired, run the query again with read-cypher", args.Cursor)), nil
+	}
+
+	ctx, err = tools.DatabaseContext(ctx, cfg, page.Database)
+	if err != nil {
+		log.Printf("Rejected database %q: %v", page.Database, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ctx, err = tools.QueryTimeoutContext(ctx, cfg, page.Timeout)
+	if err != nil {
+		log.Printf("Rejected timeout %v: %v", page.Timeout, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	formatter, err := recordFormatter(cfg, page.Format)
+	if err != nil {
+		log.Printf("Rejected format %q: %v", page.Format, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	opts := readOptions(cfg)
+	vectors := vectorOptions(cfg, page.RawVectors)
+	opts.RecordSize = recordSize(formatter, vectors)
+	opts.Skip = page.Offset
+	opts.Bookmarks = page.Bookmarks
+	result, err := dbService.ExecuteReadQueryWithOptions(ctx, page.Query, page.Params, opts)
+	if err != nil {
+		log.Printf("Error executing Cypher query: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	budget, limit, err := formatPage(formatter, result, opts, vectors, page.MaxTokens)
+	if err != nil {
+		log.Printf("Error formatting query results: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// The cursor stays open while pages remain and the last page moved it forward
+	if !result.Truncated || len(result.Records) == 0 {
+		cursors.Close(sessionID, args.Cursor)
+	}
+	if !result.Truncated {
+		return withSummary(withBudgetReport(mcp.NewToolResultText(budget.Text), budget, page.MaxTokens), nil, result.Summary), nil
+	}
+
+	cursorID := ""
+	if len(result.Records) > 0 {
+		next := page
+		next.Offset += len(result.Records)
+		next.Bookmarks = result.Bookmarks
+		if err := cursors.Update(sessionID, args.Cursor, next); err == nil {
+			cursorID = args.Cursor
+		}
+	}
+	return withSummary(withBudgetReport(truncatedResult(budget.Text, result, limit, page.Offset, cursorID), budget, page.MaxTokens), nil, result.Summary), nil
+}
@@ -0,0 +1,173 @@
+package cypher_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/cursor"
+	"github.com/neo4j/mcp/internal/database"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchMoreHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("fetch-more").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	query := "MATCH (n) RETURN n"
+	cfg := &config.Config{Database: "neo4j", AllowedDatabases: []string{"movies"}, MaxRows: 2}
+	page := cursor.Cursor{Query: query, Database: "movies", Offset: 2, Bookmarks: []string{"bookmark-1"}}
+	wantOpts := database.ReadOptions{MaxRows: 2, Skip: 2, Bookmarks: []string{"bookmark-1"}}
+
+	request := func(id string) mcp.CallToolRequest {
+		return mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"cursor": id}}}
+	}
+
+	t.Run("returns the next page and moves the cursor forward", func(t *testing.T) {
+		cursors := cursor.NewStore(time.Minute, 0)
+		id := cursors.Open("", page)
+
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQueryWithOptions(targetsDatabase("movies"), query, gomock.Nil(), hasReadOptions(wantOpts)).
+			Return(&database.ReadResult{Records: []*neo4j.Record{{}, {}}, Truncated: true, OmittedRows: 1, Bookmarks: []string{"bookmark-2"}}, nil)
+
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, Cursors: cursors}
+		result, err := cypher.FetchMoreHandler(deps)(context.Background(), request(id))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError || len(result.Content) != 2 {
+			t.Fatalf("Expected records and truncation notice, got %+v", result)
+		}
+		notice := result.Content[1].(mcp.TextContent).Text
+		if !strings.Contains(notice, "rows 3-4 of 5") || !strings.Contains(notice, id) {
+			t.Errorf("Expected notice for rows 3-4 with the same cursor, got %q", notice)
+		}
+
+		next, err := cursors.Get("", id)
+		if err != nil {
+			t.Fatalf("Expected the cursor to stay open, got: %v", err)
+		}
+		if next.Offset != 4 || next.Bookmarks[0] != "bookmark-2" {
+			t.Errorf("Expected cursor at offset 4 with the new bookmarks, got %+v", next)
+		}
+	})
+
+	t.Run("runs the page with the timeout of the query", func(t *testing.T) {
+		cursors := cursor.NewStore(time.Minute, 0)
+		timed := page
+		timed.Timeout = 120
+		id := cursors.Open("", timed)
+
+		hasTimeout := gomock.Cond(func(ctx context.Context) bool {
+			timeout, ok := database.TxTimeoutFromContext(ctx)
+			return ok && timeout == 2*time.Minute
+		})
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQueryWithOptions(hasTimeout, query, gomock.Nil(), hasReadOptions(wantOpts)).
+			Return(&database.ReadResult{Records: []*neo4j.Record{{}}}, nil)
+
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, Cursors: cursors}
+		result, err := cypher.FetchMoreHandler(deps)(context.Background(), request(id))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected the next page, got %+v", result)
+		}
+	})
+
+	t.Run("closes the cursor after the last page", func(t *testing.T) {
+		cursors := cursor.NewStore(time.Minute, 0)
+		id := cursors.Open("", page)
+
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), hasReadOptions(wantOpts)).
+			Return(&database.ReadResult{Records: []*neo4j.Record{{}}}, nil)
+
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, Cursors: cursors}
+		result, err := cypher.FetchMoreHandler(deps)(context.Background(), request(id))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError || len(result.Content) != 1 {
+			t.Fatalf("Expected only records, got %+v", result)
+		}
+		if _, err := cursors.Get("", id); !errors.Is(err, cursor.ErrNotFound) {
+			t.Errorf("Expected the cursor to be closed, got: %v", err)
+		}
+	})
+
+	t.Run("unknown cursor", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, Cursors: cursor.NewStore(time.Minute, 0)}
+
+		result, err := cypher.FetchMoreHandler(deps)(context.Background(), request("unknown"))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("empty cursor", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, Cursors: cursor.NewStore(time.Minute, 0)}
+
+		result, err := cypher.FetchMoreHandler(deps)(context.Background(), request(""))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("pagination disabled", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg}
+
+		result, err := cypher.FetchMoreHandler(deps)(context.Background(), request("any"))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("database query failure", func(t *testing.T) {
+		cursors := cursor.NewStore(time.Minute, 0)
+		id := cursors.Open("", page)
+
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), hasReadOptions(wantOpts)).
+			Return(nil, errors.New("connection failed"))
+
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, Cursors: cursors}
+		result, err := cypher.FetchMoreHandler(deps)(context.Background(), request(id))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package cypher
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type FetchMoreInput struct {
+	Cursor string `json:"cursor" jsonschema:"required,description=The cursor returned with a truncated read-cypher or fetch-more result"`
+}
+
+func FetchMoreSpec() mcp.Tool {
+	return mcp.NewTool("fetch-more",
+		mcp.WithDescription("fetch-more returns the next page of a truncated read-cypher result, identified by the cursor returned with the previous page. "+
+			"Each page runs the query again with the timeout of the read-cypher call and skips the rows already returned, "+
+			"so a page costs as much as reading every row up to its end: prefer refining the query over fetching many pages. "+
+			"Without an ORDER BY on unique values, "+
+			"Neo4j may return the rows in another order, so pages can repeat or miss rows. "+
+			"Cursors expire after a few minutes of inactivity; when a cursor is unknown or expired, run the query again with read-cypher."),
+		mcp.WithInputSchema[FetchMoreInput](),
+		mcp.WithTitleAnnotation("Fetch More Results"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,103 @@
+package cypher
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/format"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// budgetPrefix introduces the report of the values elided to fit the token budget of a tool call
+const budgetPrefix = "Token budget: "
+
+// budgetReport tells the client which values were elided from the records to fit the token budget
+type budgetReport struct {
+	MaxTokens       int              `json:"maxTokens"`
+	EstimatedTokens int              `json:"estimatedTokens"`
+	Elided          []format.Elision `json:"elided,omitempty"`
+	OmittedRows     int              `json:"omittedRows,omitempty"`
+}
+
+// formatName returns the format requested by a tool call, the server default when none is requested
+func formatName(cfg *config.Config, requested string) string {
+	if requested != "" {
+		return requested
+	}
+	if cfg != nil && cfg.ResultFormat != "" {
+		return cfg.ResultFormat
+	}
+	return config.ResultFormatJSON
+}
+
+// recordFormatter returns the formatter of the format requested by a tool call, the server default when none is requested
+func recordFormatter(cfg *config.Config, requested string) (format.RecordFormatter, error) {
+	return format.Get(formatName(cfg, requested))
+}
+
+// tokenBudget returns the token budget requested by a tool call, the server default when none is requested, 0 when there is none
+func tokenBudget(cfg *config.Config, requested int) (int, error) {
+	if requested < 0 {
+		return 0, fmt.Errorf("max_tokens must not be negative but was %d", requested)
+	}
+	if requested == 0 && cfg != nil {
+		return cfg.MaxTokens, nil
+	}
+	return requested, nil
+}
+
+// vectorOptions returns the vectors left out of the records returned by a tool call, none when raw vectors are requested
+func vectorOptions(cfg *config.Config, raw bool) database.VectorOptions {
+	if raw || cfg == nil {
+		return database.VectorOptions{}
+	}
+	return database.VectorOptions{MinDimensions: cfg.VectorMinDimensions, Properties: cfg.VectorProperties}
+}
+
+// recordSize returns the size of a record once formatted by formatter with the vectors selected by vectors left out
+func recordSize(formatter format.RecordFormatter, vectors database.VectorOptions) func(record *neo4j.Record) int {
+	return func(record *neo4j.Record) int {
+		return formatter.RecordSize(database.ElideRecordVectors(record, vectors))
+	}
+}
+
+// formatPage formats the records of a read result within maxTokens, with the vectors selected by vectors left out,
+// leaving the records past the budget out of result. It returns the formatted records and the limit result was truncated by.
+func formatPage(formatter format.RecordFormatter, result *database.ReadResult, opts database.ReadOptions, vectors database.VectorOptions, maxTokens int) (*format.BudgetResult, string, error) {
+	limit := readLimit(opts, result)
+	budget, err := format.FormatWithinBudget(formatter, result.Keys, database.ElideVectors(result.Records, vectors), maxTokens)
+	if err != nil {
+		return nil, "", err
+	}
+	if budget.OmittedRecords > 0 {
+		result.Records = result.Records[:len(result.Records)-budget.OmittedRecords]
+		result.OmittedRows += budget.OmittedRecords
+		result.Truncated = true
+		limit = fmt.Sprintf("the budget of %d tokens", maxTokens)
+	}
+	return budget, limit, nil
+}
+
+// withBudgetReport appends to a tool result the values elided from its records to fit maxTokens, when any were
+func withBudgetReport(result *mcp.CallToolResult, budget *format.BudgetResult, maxTokens int) *mcp.CallToolResult {
+	if len(budget.Elisions) == 0 && budget.OmittedRecords == 0 {
+		return result
+	}
+
+	formatted, err := json.Marshal(budgetReport{
+		MaxTokens:       maxTokens,
+		EstimatedTokens: budget.Tokens,
+		Elided:          budget.Elisions,
+		OmittedRows:     budget.OmittedRecords,
+	})
+	if err != nil {
+		log.Printf("Error formatting token budget report: %v", err)
+		return result
+	}
+	result.Content = append(result.Content, mcp.NewTextContent(budgetPrefix+string(formatted)))
+	return result
+}
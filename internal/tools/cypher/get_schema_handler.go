@@ -6,30 +6,22 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
 	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/schema"
+	"github.com/neo4j/mcp/internal/schemacache"
 	"github.com/neo4j/mcp/internal/tools"
 )
 
-const (
-	// schemaQuery is the APOC query used to retrieve comprehensive schema information
-	schemaQuery = `
-        CALL apoc.meta.schema()
-        YIELD value
-        UNWIND keys(value) as key
-        WITH key, value[key] as value
-        RETURN key, value { .properties, .type, .relationships } as value
-    `
-)
-
 // GetSchemaHandler returns a handler function for the get_schema tool
 func GetSchemaHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	return func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		return handleGetSchema(ctx, deps.DBService, deps.AnalyticsService)
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetSchema(ctx, request, deps.DBService, deps.AnalyticsService, deps.Config, deps.SchemaCache)
 	}
 }
 
-// handleGetSchema retrieves Neo4j schema information using APOC
-func handleGetSchema(ctx context.Context, dbService database.Service, asService analytics.Service) (*mcp.CallToolResult, error) {
+// handleGetSchema retrieves the Neo4j schema as structured content with a compact text rendering
+func handleGetSchema(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service, cfg *config.Config, cache *schemacache.Cache) (*mcp.CallToolResult, error) {
 	if asService == nil {
 		errMessage := "Analytics service is not initialized"
 		log.Printf("%s", errMessage)
@@ -42,22 +34,33 @@ func handleGetSchema(ctx context.Context, dbService database.Service, asService
 	}
 
 	asService.EmitEvent(asService.NewToolsEvent("get-schema"))
+	var args GetSchemaInput
+	if err := request.BindArguments(&args); err != nil {
+		log.Printf("Error binding arguments: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
-	// Execute the APOC schema query
-	records, err := dbService.ExecuteReadQuery(ctx, schemaQuery, nil)
+	ctx, err := tools.DatabaseContext(ctx, cfg, args.Database)
 	if err != nil {
-		log.Printf("Failed to execute schema query: %v", err)
+		log.Printf("Rejected database %q: %v", args.Database, err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
-	if len(records) == 0 {
-		return mcp.NewToolResultText("The get-schema tool executed successfully; however, since the Neo4j instance contains no data, no schema information was returned."), nil
-	}
-	// Convert records to JSON using the existing utility function
-	response, err := dbService.Neo4jRecordsToJSON(records)
 
+	opts := schema.Options{
+		SampleSize:               args.SampleSize,
+		IncludeLabels:            args.IncludeLabels,
+		ExcludeLabels:            args.ExcludeLabels,
+		IncludeRelationshipTypes: args.IncludeRelationshipTypes,
+		ExcludeRelationshipTypes: args.ExcludeRelationshipTypes,
+		MaxProperties:            args.MaxProperties,
+	}
+	graphSchema, err := tools.ReadSchema(ctx, dbService, cfg, cache, opts, args.Refresh)
 	if err != nil {
-		log.Printf("Failed to format schema results to JSON: %v", err)
+		log.Printf("Failed to retrieve schema: %v", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
-	return mcp.NewToolResultText(response), nil
+	if graphSchema.IsEmpty() {
+		return mcp.NewToolResultStructured(graphSchema, "The get-schema tool executed successfully; however, since the Neo4j instance contains no data, no schema information was returned."), nil
+	}
+	return mcp.NewToolResultStructured(graphSchema, graphSchema.Text()), nil
 }
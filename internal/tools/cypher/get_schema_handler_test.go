@@ -3,11 +3,17 @@ package cypher_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
 	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/schema"
+	"github.com/neo4j/mcp/internal/schemacache"
 
 	"github.com/neo4j/mcp/internal/tools"
 	"github.com/neo4j/mcp/internal/tools/cypher"
@@ -25,16 +31,21 @@ func TestGetSchemaHandler(t *testing.T) {
 	t.Run("successful schema retrieval", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 		mockDB.EXPECT().
-			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return([]*neo4j.Record{
 				{
-					Values: []any{"value1"},
-					Keys:   []string{"key1"},
+					Values: []any{map[string]any{
+						"Person": map[string]any{"type": "node", "count": int64(2), "properties": map[string]any{
+							"name": map[string]any{"type": "STRING"},
+						}},
+					}},
+					Keys: []string{"value"},
 				},
 			}, nil)
 		mockDB.EXPECT().
-			Neo4jRecordsToJSON(gomock.Any()).
-			Return(`{"schema": "data"}`, nil)
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*neo4j.Record{}, nil).
+			Times(2)
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
@@ -48,14 +59,72 @@ func TestGetSchemaHandler(t *testing.T) {
 			t.Errorf("Expected no error, got: %v", err)
 		}
 		if result == nil || result.IsError {
-			t.Error("Expected success result")
+			t.Fatal("Expected success result")
+		}
+		graphSchema, ok := result.StructuredContent.(*schema.Schema)
+		if !ok || len(graphSchema.Labels) != 1 || graphSchema.Labels[0].Name != "Person" {
+			t.Errorf("Expected structured schema with the Person label, got: %+v", result.StructuredContent)
+		}
+		textContent := result.Content[0].(mcp.TextContent)
+		if !strings.Contains(textContent.Text, "(:Person {name: STRING}) 2 nodes") {
+			t.Errorf("Expected text rendering of the schema, got: %q", textContent.Text)
 		}
 	})
 
-	t.Run("database query failure", func(t *testing.T) {
+	t.Run("scoping arguments", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), map[string]any{"config": map[string]any{"sample": 50, "labels": []any{"Person"}}}).
+			Return([]*neo4j.Record{}, nil)
 		mockDB.EXPECT().
 			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return([]*neo4j.Record{}, nil).
+			Times(2)
+
+		deps := &tools.ToolDependencies{
+			DBService:        mockDB,
+			AnalyticsService: analyticsService,
+		}
+
+		handler := cypher.GetSchemaHandler(deps)
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"sampleSize":    50,
+			"includeLabels": []any{"Person"},
+		}}})
+
+		if err != nil {
+			t.Errorf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Errorf("Expected success result, got: %+v", result)
+		}
+	})
+
+	t.Run("invalid scoping arguments", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+
+		deps := &tools.ToolDependencies{
+			DBService:        mockDB,
+			AnalyticsService: analyticsService,
+		}
+
+		handler := cypher.GetSchemaHandler(deps)
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"maxProperties": -1,
+		}}})
+
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for a negative maxProperties")
+		}
+	})
+
+	t.Run("database query failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil, errors.New("connection failed"))
 
 		deps := &tools.ToolDependencies{
@@ -74,19 +143,15 @@ func TestGetSchemaHandler(t *testing.T) {
 		}
 	})
 
-	t.Run("JSON formatting failure", func(t *testing.T) {
+	t.Run("index listing failure", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 		mockDB.EXPECT().
-			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
-			Return([]*neo4j.Record{
-				{
-					Values: []any{"value1"},
-					Keys:   []string{"key1"},
-				},
-			}, nil)
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*neo4j.Record{}, nil)
 		mockDB.EXPECT().
-			Neo4jRecordsToJSON(gomock.Any()).
-			Return("", errors.New("JSON marshaling failed"))
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("permission denied")).
+			Times(2)
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
@@ -99,8 +164,8 @@ func TestGetSchemaHandler(t *testing.T) {
 		if err != nil {
 			t.Errorf("Expected no error from handler, got: %v", err)
 		}
-		if result == nil || !result.IsError {
-			t.Error("Expected error result")
+		if result == nil || result.IsError {
+			t.Error("Expected success result when indexes and constraints cannot be listed")
 		}
 	})
 
@@ -143,8 +208,9 @@ func TestGetSchemaHandler(t *testing.T) {
 		analyticsService.EXPECT().EmitEvent(gomock.Any()).Times(1)
 		mockDB := db.NewMockService(ctrl)
 		mockDB.EXPECT().
-			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
-			Return([]*neo4j.Record{}, nil)
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*neo4j.Record{}, nil).
+			Times(3)
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
@@ -174,3 +240,102 @@ func TestGetSchemaHandler(t *testing.T) {
 		}
 	})
 }
+
+func TestGetSchemaHandlerCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent(gomock.Any()).AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Database: "neo4j"}
+	// introspection expects the three queries reading the schema of an empty database
+	introspection := func(mockDB *db.MockService) {
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*neo4j.Record{}, nil).Times(3)
+	}
+	getSchema := func(t *testing.T, deps *tools.ToolDependencies, ctx context.Context, arguments map[string]any) {
+		t.Helper()
+		result, err := cypher.GetSchemaHandler(deps)(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+		if err != nil || result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %+v, %v", result, err)
+		}
+	}
+
+	t.Run("schema is read once", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		introspection(mockDB)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, SchemaCache: schemacache.New(time.Minute)}
+
+		getSchema(t, deps, context.Background(), nil)
+		getSchema(t, deps, context.Background(), nil)
+	})
+
+	t.Run("refresh reads the schema again", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		introspection(mockDB)
+		introspection(mockDB)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, SchemaCache: schemacache.New(time.Minute)}
+
+		getSchema(t, deps, context.Background(), nil)
+		getSchema(t, deps, context.Background(), map[string]any{"refresh": true})
+		getSchema(t, deps, context.Background(), nil)
+	})
+
+	t.Run("scoped schemas are cached separately", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		introspection(mockDB)
+		introspection(mockDB)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, SchemaCache: schemacache.New(time.Minute)}
+
+		getSchema(t, deps, context.Background(), nil)
+		getSchema(t, deps, context.Background(), map[string]any{"includeLabels": []any{"Person"}})
+	})
+
+	t.Run("write-cypher changing labels invalidates the schema", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		introspection(mockDB)
+		mockDB.EXPECT().ExecuteWriteQueryWithCounters(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&database.WriteResult{Counters: database.Counters{PropertiesSet: 1}}, nil)
+		mockDB.EXPECT().ExecuteWriteQueryWithCounters(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&database.WriteResult{Counters: database.Counters{NodesCreated: 1, LabelsAdded: 1}}, nil)
+		introspection(mockDB)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, SchemaCache: schemacache.New(time.Minute)}
+		write := func(query string) {
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query}}}
+			if result, err := cypher.WriteCypherHandler(deps)(context.Background(), request); err != nil || result.IsError {
+				t.Fatalf("Expected success result, got: %+v, %v", result, err)
+			}
+		}
+
+		getSchema(t, deps, context.Background(), nil)
+		write("MATCH (n:Person) SET n.seen = true")
+		getSchema(t, deps, context.Background(), nil)
+		write("CREATE (:Company)")
+		getSchema(t, deps, context.Background(), nil)
+	})
+
+	t.Run("schema is not shared between anonymous per-request credentials", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		introspection(mockDB)
+		introspection(mockDB)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, SchemaCache: schemacache.New(time.Minute)}
+		ctx := database.WithAuthToken(context.Background(), neo4j.BearerAuth("token"))
+
+		getSchema(t, deps, ctx, nil)
+		getSchema(t, deps, ctx, nil)
+	})
+
+	t.Run("schema is cached per per-request credentials", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		introspection(mockDB)
+		introspection(mockDB)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, SchemaCache: schemacache.New(time.Minute)}
+		alice := database.WithAuthToken(context.Background(), neo4j.BasicAuth("alice", "secret", ""))
+		impostor := database.WithAuthToken(context.Background(), neo4j.BasicAuth("alice", "wrong", ""))
+
+		getSchema(t, deps, alice, nil)
+		getSchema(t, deps, alice, nil)
+		// A wrong password must reach Neo4j instead of being served alice's schema
+		getSchema(t, deps, impostor, nil)
+	})
+}
@@ -2,14 +2,28 @@ package cypher
 
 import (
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/schema"
 )
 
-// TODO: define an optimized get-schema output and add it as outputSchema
+type GetSchemaInput struct {
+	Database                 string   `json:"database,omitempty" jsonschema:"description=Name of the database to retrieve the schema of, defaults to the configured database. Use list-databases to find the available databases"`
+	SampleSize               int      `json:"sampleSize,omitempty" jsonschema:"minimum=0,description=Number of nodes sampled per label to infer properties and relationships, lower is faster on large graphs. Only applies when APOC is used"`
+	IncludeLabels            []string `json:"includeLabels,omitempty" jsonschema:"description=Only describe the labels matching one of these patterns, e.g. Person or Order*"`
+	ExcludeLabels            []string `json:"excludeLabels,omitempty" jsonschema:"description=Leave out the labels matching one of these patterns"`
+	IncludeRelationshipTypes []string `json:"includeRelationshipTypes,omitempty" jsonschema:"description=Only describe the relationship types matching one of these patterns, e.g. ACTED_IN or HAS_*"`
+	ExcludeRelationshipTypes []string `json:"excludeRelationshipTypes,omitempty" jsonschema:"description=Leave out the relationship types matching one of these patterns"`
+	MaxProperties            int      `json:"maxProperties,omitempty" jsonschema:"minimum=0,description=Maximum number of properties listed per label or relationship type, unique, required and indexed properties first"`
+	Refresh                  bool     `json:"refresh,omitempty" jsonschema:"description=Read the schema from the database again instead of returning the cached one"`
+}
+
 func GetSchemaSpec() mcp.Tool {
 	return mcp.NewTool("get-schema",
 		mcp.WithDescription(`
-		Retrieve the schema information from the Neo4j database, including node labels, relationship types, and property keys.
-		If the database contains no data, no schema information is returned.`),
+		Retrieve the schema information from the Neo4j database, including node labels, relationship types with the labels they connect, properties with their types, indexes and constraints.
+		If the database contains no data, no schema information is returned.
+		On large graphs, scope the schema to the labels and relationship types of interest with the include and exclude patterns.`),
+		mcp.WithInputSchema[GetSchemaInput](),
+		mcp.WithOutputSchema[schema.Schema](),
 		mcp.WithTitleAnnotation("Get Neo4j Schema"),
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithIdempotentHintAnnotation(true),
@@ -0,0 +1,73 @@
+package cypher
+
+import (
+	"context"
+	"log"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+const (
+	// systemDatabase is the database administration commands such as SHOW DATABASES run against
+	systemDatabase = "system"
+
+	// listDatabasesQuery returns one row per database: SHOW DATABASES returns one per server hosting it in a cluster
+	listDatabasesQuery = `
+SHOW DATABASES YIELD name, currentStatus, role, default
+RETURN name, collect(DISTINCT currentStatus) AS statuses, collect(DISTINCT role) AS roles, default
+ORDER BY name`
+)
+
+// ListDatabasesHandler returns a handler function for the list-databases tool
+func ListDatabasesHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleListDatabases(ctx, deps.DBService, deps.AnalyticsService, deps.Config)
+	}
+}
+
+// handleListDatabases lists the databases of the Neo4j instance that tools are allowed to target
+func handleListDatabases(ctx context.Context, dbService database.Service, asService analytics.Service, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if asService == nil {
+		errMessage := "Analytics service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if dbService == nil {
+		errMessage := "Database service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	asService.EmitEvent(asService.NewToolsEvent("list-databases"))
+
+	records, err := dbService.ExecuteReadQuery(database.WithDatabase(ctx, systemDatabase), listDatabasesQuery, nil)
+	if err != nil {
+		log.Printf("Failed to execute list-databases query: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Only report the databases the other tools accept, when the configuration restricts them
+	allowed := make([]*neo4j.Record, 0, len(records))
+	for _, record := range records {
+		name, _, err := neo4j.GetRecordValue[string](record, "name")
+		if err != nil {
+			log.Printf("Failed to read database name: %v", err)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if cfg == nil || cfg.DatabaseAllowed(name) {
+			allowed = append(allowed, record)
+		}
+	}
+
+	response, err := tools.FormatRecords(cfg, allowed)
+	if err != nil {
+		log.Printf("Failed to format list-databases results: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(response), nil
+}
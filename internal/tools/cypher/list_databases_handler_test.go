@@ -0,0 +1,114 @@
+package cypher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListDatabasesHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("list-databases").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	databases := func() []*neo4j.Record {
+		keys := []string{"name", "statuses", "roles", "default"}
+		return []*neo4j.Record{
+			{Keys: keys, Values: []any{"movies", []any{"online"}, []any{"primary", "secondary"}, false}},
+			{Keys: keys, Values: []any{"neo4j", []any{"online"}, []any{"primary"}, true}},
+			{Keys: keys, Values: []any{"system", []any{"online"}, []any{"primary"}, false}},
+		}
+	}
+
+	// names returns the names of the databases listed by a JSON result
+	names := func(t *testing.T, text string) []string {
+		t.Helper()
+		var listed []map[string]any
+		if err := json.Unmarshal([]byte(text), &listed); err != nil {
+			t.Fatalf("Expected a JSON result, got %q: %v", text, err)
+		}
+		result := make([]string, 0, len(listed))
+		for _, database := range listed {
+			result = append(result, database["name"].(string))
+		}
+		return result
+	}
+
+	tests := []struct {
+		name    string
+		allowed []string
+		want    []string
+	}{
+		{name: "only the default database without allowlist", want: []string{"neo4j"}},
+		{name: "allowed databases", allowed: []string{"movies"}, want: []string{"movies", "neo4j"}},
+		{name: "every database with wildcard", allowed: []string{config.AllowAnyDatabase}, want: []string{"movies", "neo4j", "system"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			mockDB.EXPECT().
+				ExecuteReadQuery(targetsDatabase("system"), gomock.Any(), gomock.Nil()).
+				Return(databases(), nil)
+
+			deps := &tools.ToolDependencies{
+				DBService:        mockDB,
+				AnalyticsService: analyticsService,
+				Config:           &config.Config{Database: "neo4j", AllowedDatabases: tt.allowed},
+			}
+
+			result, err := cypher.ListDatabasesHandler(deps)(context.Background(), mcp.CallToolRequest{})
+			if err != nil {
+				t.Errorf("Expected no error, got: %v", err)
+			}
+			if result == nil || result.IsError {
+				t.Fatal("Expected success result")
+			}
+			if got := names(t, result.Content[0].(mcp.TextContent).Text); !slices.Equal(got, tt.want) {
+				t.Errorf("Expected databases %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("database query failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return(nil, errors.New("permission denied"))
+
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService}
+
+		result, err := cypher.ListDatabasesHandler(deps)(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("nil database service", func(t *testing.T) {
+		deps := &tools.ToolDependencies{AnalyticsService: analyticsService}
+
+		result, err := cypher.ListDatabasesHandler(deps)(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+}
@@ -0,0 +1,17 @@
+package cypher
+
+import "github.com/mark3labs/mcp-go/mcp"
+
+func ListDatabasesSpec() mcp.Tool {
+	return mcp.NewTool("list-databases",
+		mcp.WithDescription(
+			"List the Neo4j databases available to this server, with their name, the statuses and roles of their copies across the cluster and whether they are the default database. "+
+				"Pass one of the returned names as the database argument of read-cypher, write-cypher or get-schema to query another database than the default one.",
+		),
+		mcp.WithTitleAnnotation("List Neo4j Databases"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,56 @@
+package cypher
+
+import (
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+)
+
+// readOptions returns the result limits of read-cypher and fetch-more from the configuration
+func readOptions(cfg *config.Config) database.ReadOptions {
+	if cfg == nil {
+		return database.ReadOptions{}
+	}
+	return database.ReadOptions{MaxRows: cfg.MaxRows, MaxBytes: cfg.MaxResponseBytes}
+}
+
+// readLimit describes the limit of opts a truncated result reached
+func readLimit(opts database.ReadOptions, result *database.ReadResult) string {
+	if opts.MaxRows > 0 && len(result.Records) >= opts.MaxRows {
+		return fmt.Sprintf("the maximum of %d rows", opts.MaxRows)
+	}
+	return fmt.Sprintf("the maximum response size of %d bytes", opts.MaxBytes)
+}
+
+// truncatedResult returns the formatted records of a truncated result, followed by a notice telling the client
+// which limit was reached, how many rows were omitted and, when cursorID is set, how to fetch the next page.
+// offset is the number of rows returned by previous pages.
+func truncatedResult(response string, result *database.ReadResult, limit string, offset int, cursorID string) *mcp.CallToolResult {
+	returned := len(result.Records)
+	// the omitted rows are counted up to a cap, past which only their existence is known
+	atLeast := ""
+	if result.MoreRows {
+		atLeast = "more than "
+	}
+
+	notice := fmt.Sprintf("WARNING: the result was truncated to rows %d-%d of %s%d (%s%d rows omitted) because it exceeded %s. ",
+		offset+1, offset+returned, atLeast, offset+returned+result.OmittedRows, atLeast, result.OmittedRows, limit)
+	if returned == 0 {
+		notice = fmt.Sprintf("WARNING: the result was truncated to no rows (%s%d rows omitted) because row %d alone exceeded %s. ",
+			atLeast, result.OmittedRows, offset+1, limit)
+	}
+	if cursorID != "" {
+		notice += fmt.Sprintf("Call fetch-more with cursor %q to retrieve the next page.", cursorID)
+	} else {
+		notice += "Refine the query, for example with LIMIT, filters or aggregations, to retrieve the omitted rows."
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(response),
+			mcp.NewTextContent(notice),
+		},
+	}
+}
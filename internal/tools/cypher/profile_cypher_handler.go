@@ -0,0 +1,108 @@
+package cypher
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// ProfileCypherHandler returns a handler function for the profile-cypher tool
+func ProfileCypherHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleProfileCypher(ctx, request, deps.DBService, deps.AnalyticsService, deps.Config)
+	}
+}
+
+// handleProfileCypher returns the profiled plan of a read-only query as structured content with a compact text rendering
+func handleProfileCypher(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if asService == nil {
+		errMessage := "Analytics service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if dbService == nil {
+		errMessage := "Database service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	asService.EmitEvent(asService.NewToolsEvent("profile-cypher"))
+	var args ProfileCypherInput
+	// Use our custom BindArguments that preserves integer types
+	if err := BindArguments(request, &args); err != nil {
+		log.Printf("Error binding arguments: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if args.Query == "" {
+		errMessage := "Query parameter is required and cannot be empty"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	ctx, err := tools.DatabaseContext(ctx, cfg, args.Database)
+	if err != nil {
+		log.Printf("Rejected database %q: %v", args.Database, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ctx, err = tools.QueryTimeoutContext(ctx, cfg, args.Timeout)
+	if err != nil {
+		log.Printf("Rejected timeout %v: %v", args.Timeout, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// The query runs for real, only read-only queries are profiled like read-cypher only runs them
+	queryType, err := dbService.GetQueryType(ctx, args.Query, args.Params)
+	if err != nil {
+		log.Printf("Error while classifying Cypher query: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if queryType != neo4j.StatementTypeReadOnly {
+		errMessage := "profile-cypher can only profile read-only Cypher statements. Use explain-cypher to see the plan of write statements without running them."
+		log.Printf("Rejected non-read query (type=%v): %v", queryType, args.Query)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	result, err := dbService.ProfileQuery(ctx, args.Query, args.Params)
+	if err != nil {
+		log.Printf("Error profiling Cypher query: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultStructured(result, profileText(result)), nil
+}
+
+// profileText renders a profiled query as an indented operator tree followed by the notifications, e.g.
+//
+//	Statement type: READ_ONLY, 3 rows, 4 db hits
+//	Plan:
+//	ProduceResults@neo4j (rows: 3, estimated rows: 3, db hits: 0, page cache hits/misses: 1/1, time: 0.001 ms) n
+//	  AllNodesScan@neo4j (rows: 3, estimated rows: 3, db hits: 4, page cache hits/misses: 2/0, time: 0.002 ms) n
+func profileText(result *database.ProfileResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Statement type: %s, %d rows, %d db hits\n", result.StatementType, result.Rows, result.DbHits)
+	if result.Plan != nil {
+		b.WriteString("Plan:\n")
+		writeProfiledPlan(&b, result.Plan, 0)
+	}
+	writeNotifications(&b, result.Notifications)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// writeProfiledPlan writes an operator and its children, indented by depth
+func writeProfiledPlan(b *strings.Builder, plan *database.ProfiledPlan, depth int) {
+	fmt.Fprintf(b, "%s%s (rows: %d, estimated rows: %g, db hits: %d, page cache hits/misses: %d/%d, time: %g ms)%s\n",
+		strings.Repeat("  ", depth), plan.Operator, plan.Rows, plan.EstimatedRows, plan.DbHits,
+		plan.PageCacheHits, plan.PageCacheMisses, float64(plan.TimeNs)/1e6, operatorDetails(plan.Arguments, plan.Identifiers))
+	for _, child := range plan.Children {
+		writeProfiledPlan(b, child, depth+1)
+	}
+}
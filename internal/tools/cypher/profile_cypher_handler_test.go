@@ -0,0 +1,94 @@
+package cypher_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/database"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProfileCypherHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("profile-cypher").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+
+	query := "MATCH (n:Person) WHERE n.name = $name RETURN n"
+	params := map[string]any{"name": "Alice"}
+	request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query, "params": params}}}
+
+	t.Run("returns the profiled plan", func(t *testing.T) {
+		profiled := &database.ProfileResult{
+			StatementType: "READ_ONLY",
+			Rows:          1,
+			DbHits:        6,
+			Plan: &database.ProfiledPlan{
+				Operator: "ProduceResults@neo4j", EstimatedRows: 1, Rows: 1, Identifiers: []string{"n"},
+				Children: []*database.ProfiledPlan{{
+					Operator: "NodeByLabelScan@neo4j", EstimatedRows: 2, Rows: 1, DbHits: 6, PageCacheHits: 3, TimeNs: 1500000,
+					Arguments: map[string]any{"Details": "n:Person"},
+				}},
+			},
+		}
+		mockDB := db.NewMockService(ctrl)
+		gomock.InOrder(
+			mockDB.EXPECT().GetQueryType(gomock.Any(), query, params).Return(neo4j.StatementTypeReadOnly, nil),
+			mockDB.EXPECT().ProfileQuery(gomock.Any(), query, params).Return(profiled, nil),
+		)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService}
+
+		result, err := cypher.ProfileCypherHandler(deps)(context.Background(), request)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("unexpected error result: %+v", result)
+		}
+		if result.StructuredContent != profiled {
+			t.Errorf("expected the profiled query as structured content, got %+v", result.StructuredContent)
+		}
+
+		text := result.Content[0].(mcp.TextContent).Text
+		want := "Statement type: READ_ONLY, 1 rows, 6 db hits\n" +
+			"Plan:\n" +
+			"ProduceResults@neo4j (rows: 1, estimated rows: 1, db hits: 0, page cache hits/misses: 0/0, time: 0 ms) n\n" +
+			"  NodeByLabelScan@neo4j (rows: 1, estimated rows: 2, db hits: 6, page cache hits/misses: 3/0, time: 1.5 ms) n:Person"
+		if text != want {
+			t.Errorf("expected text:\n%s\ngot:\n%s", want, text)
+		}
+	})
+
+	t.Run("rejects write queries", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().GetQueryType(gomock.Any(), "CREATE (n) RETURN n", gomock.Nil()).Return(neo4j.StatementTypeReadWrite, nil)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService}
+
+		writeRequest := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": "CREATE (n) RETURN n"}}}
+		result, err := cypher.ProfileCypherHandler(deps)(context.Background(), writeRequest)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("expected write queries to be rejected, got %+v", result)
+		}
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		deps := &tools.ToolDependencies{DBService: db.NewMockService(ctrl), AnalyticsService: analyticsService}
+
+		emptyRequest := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": ""}}}
+		result, err := cypher.ProfileCypherHandler(deps)(context.Background(), emptyRequest)
+		if err != nil || !result.IsError {
+			t.Errorf("expected an error result, got %+v, %v", result, err)
+		}
+	})
+}
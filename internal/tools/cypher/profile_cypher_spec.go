@@ -0,0 +1,91 @@
+package cypher
+
+import (
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type ProfileCypherInput struct {
+	Query    string         `json:"query" jsonschema:"default=MATCH(n) RETURN n,description=The read-only Cypher query to profile, without EXPLAIN or PROFILE"`
+	Params   map[string]any `json:"params" jsonschema:"default={},description=Parameters to pass to the Cypher query"`
+	Database string         `json:"database,omitempty" jsonschema:"description=Name of the database to profile the query against, defaults to the configured database. Use list-databases to find the available databases"`
+	Timeout  float64        `json:"timeout,omitempty" jsonschema:"description=Timeout of the query in seconds, after which Neo4j terminates it. Defaults to the server query timeout"`
+}
+
+// GetParams returns the params map
+func (r *ProfileCypherInput) GetParams() map[string]any {
+	return r.Params
+}
+
+// SetParams sets the params map
+func (r *ProfileCypherInput) SetParams(params map[string]any) {
+	r.Params = params
+}
+
+// profileOutputSchema describes the output of profile-cypher.
+// It is written by hand because plans are recursive, which the schema generated from Go types does not support.
+const profileOutputSchema = `{
+  "type": "object",
+  "properties": {
+    "statementType": {"type": "string", "enum": ["READ_ONLY", "READ_WRITE", "WRITE_ONLY", "SCHEMA_WRITE", "UNKNOWN"]},
+    "rows": {"type": "integer", "description": "Number of records the query returned"},
+    "dbHits": {"type": "integer", "description": "Db hits of every operator"},
+    "plan": {"$ref": "#/$defs/plan"},
+    "notifications": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "code": {"type": "string"},
+          "title": {"type": "string"},
+          "description": {"type": "string"},
+          "severity": {"type": "string"},
+          "category": {"type": "string"},
+          "position": {
+            "type": "object",
+            "properties": {"offset": {"type": "integer"}, "line": {"type": "integer"}, "column": {"type": "integer"}}
+          }
+        },
+        "required": ["code", "title", "description", "severity"]
+      }
+    }
+  },
+  "required": ["statementType", "rows", "dbHits", "notifications"],
+  "$defs": {
+    "plan": {
+      "type": "object",
+      "properties": {
+        "operator": {"type": "string"},
+        "estimatedRows": {"type": "number"},
+        "rows": {"type": "integer"},
+        "dbHits": {"type": "integer"},
+        "pageCacheHits": {"type": "integer"},
+        "pageCacheMisses": {"type": "integer"},
+        "pageCacheHitRatio": {"type": "number"},
+        "timeNs": {"type": "integer", "description": "Time spent in the operator in nanoseconds, 0 when the runtime does not report it"},
+        "identifiers": {"type": "array", "items": {"type": "string"}},
+        "arguments": {"type": "object"},
+        "children": {"type": "array", "items": {"$ref": "#/$defs/plan"}}
+      },
+      "required": ["operator", "estimatedRows", "rows", "dbHits", "pageCacheHits", "pageCacheMisses", "pageCacheHitRatio", "timeNs", "identifiers"]
+    }
+  }
+}`
+
+func ProfileCypherSpec() mcp.Tool {
+	return mcp.NewTool("profile-cypher",
+		mcp.WithDescription("profile-cypher runs a read-only Cypher statement under PROFILE and returns its executed plan: "+
+			"the tree of operators with the rows they produced against the estimated rows, db hits, page cache hits and misses and time, "+
+			"plus the warnings of the server. The records of the query are not returned and its transaction is always rolled back. "+
+			"Use it to find why a query is slow, e.g. the operators with the most db hits or rows far above their estimate. "+
+			"The query runs for real, prefer explain-cypher to only check a query."),
+		mcp.WithInputSchema[ProfileCypherInput](),
+		mcp.WithRawOutputSchema(json.RawMessage(profileOutputSchema)),
+		mcp.WithTitleAnnotation("Profile Cypher"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
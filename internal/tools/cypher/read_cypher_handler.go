@@ -7,6 +7,8 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/cursor"
 	"github.com/neo4j/mcp/internal/database"
 	"github.com/neo4j/mcp/internal/tools"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
@@ -14,11 +16,11 @@ import (
 
 func ReadCypherHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		return handleReadCypher(ctx, request, deps.DBService, deps.AnalyticsService)
+		return handleReadCypher(ctx, request, deps.DBService, deps.AnalyticsService, deps.Config, deps.Cursors)
 	}
 }
 
-func handleReadCypher(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service) (*mcp.CallToolResult, error) {
+func handleReadCypher(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service, cfg *config.Config, cursors *cursor.Store) (*mcp.CallToolResult, error) {
 	if asService == nil {
 		errMessage := "Analytics service is not initialized"
 		log.Printf("%s", errMessage)
@@ -58,6 +60,30 @@ func handleReadCypher(ctx context.Context, request mcp.CallToolRequest, dbServic
 		return mcp.NewToolResultError(errMessage), nil
 	}
 
+	ctx, err := tools.DatabaseContext(ctx, cfg, args.Database)
+	if err != nil {
+		log.Printf("Rejected database %q: %v", args.Database, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ctx, err = tools.QueryTimeoutContext(ctx, cfg, args.Timeout)
+	if err != nil {
+		log.Printf("Rejected timeout %v: %v", args.Timeout, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	formatter, err := recordFormatter(cfg, args.Format)
+	if err != nil {
+		log.Printf("Rejected format %q: %v", args.Format, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	maxTokens, err := tokenBudget(cfg, args.MaxTokens)
+	if err != nil {
+		log.Printf("Rejected token budget %d: %v", args.MaxTokens, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	// Get queryType by pre-appending "EXPLAIN" to identify if the query is of type "r", if not raise a ToolResultError
 	queryType, err := dbService.GetQueryType(ctx, Query, Params)
 	if err != nil {
@@ -66,23 +92,45 @@ func handleReadCypher(ctx context.Context, request mcp.CallToolRequest, dbServic
 	}
 
 	if queryType != neo4j.StatementTypeReadOnly { // only queryType == "r" are allowed in read-cypher
-		errMessage := "read-cypher can only run read-only Cypher statements. For write operations (CREATE, MERGE, DELETE, SET, etc...), schema/admin commands, or PROFILE queries, use write-cypher instead."
+		errMessage := "read-cypher can only run read-only Cypher statements. For write operations (CREATE, MERGE, DELETE, SET, etc...) or schema/admin commands, use write-cypher instead. To profile a query, use profile-cypher."
 		log.Printf("Rejected non-read query (type=%v): %v", queryType, Query)
 		return mcp.NewToolResultError(errMessage), nil
 	}
 
 	// Execute the Cypher query using the database service (now confirmed read-only)
-	records, err := dbService.ExecuteReadQuery(ctx, Query, Params)
+	opts := readOptions(cfg)
+	vectors := vectorOptions(cfg, args.RawVectors)
+	opts.RecordSize = recordSize(formatter, vectors)
+	result, err := dbService.ExecuteReadQueryWithOptions(ctx, Query, Params, opts)
 	if err != nil {
 		log.Printf("Error executing Cypher query: %v", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	response, err := dbService.Neo4jRecordsToJSON(records)
+	budget, limit, err := formatPage(formatter, result, opts, vectors, maxTokens)
 	if err != nil {
 		log.Printf("Error formatting query results: %v", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	return mcp.NewToolResultText(response), nil
+	if result.Truncated {
+		log.Printf("Truncated read-cypher result, %d rows omitted", result.OmittedRows)
+		cursorID := ""
+		if cursors != nil && len(result.Records) > 0 {
+			cursorID = cursors.Open(tools.SessionID(ctx), cursor.Cursor{
+				Query:      Query,
+				Params:     Params,
+				Database:   args.Database,
+				Offset:     len(result.Records),
+				Bookmarks:  result.Bookmarks,
+				Format:     formatName(cfg, args.Format),
+				MaxTokens:  maxTokens,
+				Timeout:    args.Timeout,
+				RawVectors: args.RawVectors,
+			})
+		}
+		return withSummary(withBudgetReport(truncatedResult(budget.Text, result, limit, 0, cursorID), budget, maxTokens), nil, result.Summary), nil
+	}
+
+	return withSummary(withBudgetReport(mcp.NewToolResultText(budget.Text), budget, maxTokens), nil, result.Summary), nil
 }
@@ -3,10 +3,16 @@ package cypher_test
 import (
 	"context"
 	"errors"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/cursor"
+	"github.com/neo4j/mcp/internal/database"
 	db "github.com/neo4j/mcp/internal/database/mocks"
 	"github.com/neo4j/mcp/internal/tools"
 	"github.com/neo4j/mcp/internal/tools/cypher"
@@ -24,14 +30,11 @@ func TestReadCypherHandler(t *testing.T) {
 	t.Run("successful cypher execution with parameters", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 		mockDB.EXPECT().
-			ExecuteReadQuery(gomock.Any(), "MATCH (n:Person {name: $name}) RETURN n", map[string]any{"name": "Alice"}).
-			Return([]*neo4j.Record{}, nil)
+			ExecuteReadQueryWithOptions(gomock.Any(), "MATCH (n:Person {name: $name}) RETURN n", map[string]any{"name": "Alice"}, gomock.Any()).
+			Return(&database.ReadResult{Records: []*neo4j.Record{}}, nil)
 		mockDB.EXPECT().
 			GetQueryType(gomock.Any(), "MATCH (n:Person {name: $name}) RETURN n", map[string]any{"name": "Alice"}).
 			Return(neo4j.StatementTypeReadOnly, nil)
-		mockDB.EXPECT().
-			Neo4jRecordsToJSON(gomock.Any()).
-			Return(`[{"n": {"name": "Alice"}}]`, nil)
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
@@ -64,11 +67,8 @@ func TestReadCypherHandler(t *testing.T) {
 			GetQueryType(gomock.Any(), "MATCH (n) RETURN count(n)", gomock.Nil()).
 			Return(neo4j.StatementTypeReadOnly, nil)
 		mockDB.EXPECT().
-			ExecuteReadQuery(gomock.Any(), "MATCH (n) RETURN count(n)", gomock.Nil()).
-			Return([]*neo4j.Record{}, nil)
-		mockDB.EXPECT().
-			Neo4jRecordsToJSON(gomock.Any()).
-			Return(`[{"count(n)": 42}]`, nil)
+			ExecuteReadQueryWithOptions(gomock.Any(), "MATCH (n) RETURN count(n)", gomock.Nil(), gomock.Any()).
+			Return(&database.ReadResult{Records: []*neo4j.Record{}}, nil)
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
@@ -122,7 +122,7 @@ func TestReadCypherHandler(t *testing.T) {
 
 	t.Run("missing required arguments", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
-		// The handler should NOT call ExecuteReadQuery when query is empty
+		// The handler should NOT call ExecuteReadQueryWithOptions when query is empty
 		// No expectations set for mockDB since it shouldn't be called
 
 		deps := &tools.ToolDependencies{
@@ -152,7 +152,7 @@ func TestReadCypherHandler(t *testing.T) {
 
 	t.Run("empty query parameter", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
-		// The handler should NOT call ExecuteReadQuery when query is empty
+		// The handler should NOT call ExecuteReadQueryWithOptions when query is empty
 		// No expectations set for mockDB since it shouldn't be called
 
 		deps := &tools.ToolDependencies{
@@ -228,7 +228,7 @@ func TestReadCypherHandler(t *testing.T) {
 			GetQueryType(gomock.Any(), "INVALID CYPHER", gomock.Nil()).
 			Return(neo4j.StatementTypeReadOnly, nil)
 		mockDB.EXPECT().
-			ExecuteReadQuery(gomock.Any(), "INVALID CYPHER", gomock.Nil()).
+			ExecuteReadQueryWithOptions(gomock.Any(), "INVALID CYPHER", gomock.Nil(), gomock.Any()).
 			Return(nil, errors.New("syntax error"))
 
 		deps := &tools.ToolDependencies{
@@ -255,17 +255,8 @@ func TestReadCypherHandler(t *testing.T) {
 		}
 	})
 
-	t.Run("JSON formatting failure", func(t *testing.T) {
+	t.Run("unknown format", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
-		mockDB.EXPECT().
-			GetQueryType(gomock.Any(), "MATCH (n) RETURN n", gomock.Nil()).
-			Return(neo4j.StatementTypeReadOnly, nil)
-		mockDB.EXPECT().
-			ExecuteReadQuery(gomock.Any(), "MATCH (n) RETURN n", gomock.Nil()).
-			Return([]*neo4j.Record{}, nil)
-		mockDB.EXPECT().
-			Neo4jRecordsToJSON(gomock.Any()).
-			Return("", errors.New("JSON marshaling failed"))
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
@@ -276,7 +267,8 @@ func TestReadCypherHandler(t *testing.T) {
 		request := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Arguments: map[string]any{
-					"query": "MATCH (n) RETURN n",
+					"query":  "MATCH (n) RETURN n",
+					"format": "xml",
 				},
 			},
 		}
@@ -287,7 +279,7 @@ func TestReadCypherHandler(t *testing.T) {
 			t.Errorf("Expected no error from handler, got: %v", err)
 		}
 		if result == nil || !result.IsError {
-			t.Error("Expected error result for JSON formatting failure")
+			t.Error("Expected error result for unknown format")
 		}
 	})
 
@@ -362,8 +354,7 @@ func TestReadCypherHandlerEvents(t *testing.T) {
 
 		query := "CALL gds.graph.project('myGraph', 'Node', 'REL')"
 		mockDB.EXPECT().GetQueryType(gomock.Any(), query, gomock.Nil()).Return(neo4j.StatementTypeReadOnly, nil)
-		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), query, gomock.Nil()).Return([]*neo4j.Record{}, nil)
-		mockDB.EXPECT().Neo4jRecordsToJSON(gomock.Any()).Return("[]", nil)
+		mockDB.EXPECT().ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), gomock.Any()).Return(&database.ReadResult{Records: []*neo4j.Record{}}, nil)
 
 		analyticServiceExplicitMock := analytics.NewMockService(ctrl)
 		analyticServiceExplicitMock.EXPECT().NewGDSProjCreatedEvent().Times(1)
@@ -399,8 +390,7 @@ func TestReadCypherHandlerEvents(t *testing.T) {
 
 		query := "CALL gds.graph.drop('myGraph')"
 		mockDB.EXPECT().GetQueryType(gomock.Any(), query, gomock.Nil()).Return(neo4j.StatementTypeReadOnly, nil)
-		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), query, gomock.Nil()).Return([]*neo4j.Record{}, nil)
-		mockDB.EXPECT().Neo4jRecordsToJSON(gomock.Any()).Return("[]", nil)
+		mockDB.EXPECT().ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), gomock.Any()).Return(&database.ReadResult{Records: []*neo4j.Record{}}, nil)
 
 		analyticServiceExplicitMock.EXPECT().NewGDSProjDropEvent().Times(1)
 		analyticServiceExplicitMock.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
@@ -426,3 +416,410 @@ func TestReadCypherHandlerEvents(t *testing.T) {
 		}
 	})
 }
+
+func TestReadCypherHandlerTruncation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("read-cypher").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	query := "MATCH (n) RETURN n"
+	cfg := &config.Config{Database: "neo4j", MaxRows: 2, MaxResponseBytes: 1024}
+
+	tests := []struct {
+		name       string
+		result     *database.ReadResult
+		wantNotice string
+	}{
+		{
+			name:   "result within limits",
+			result: &database.ReadResult{Records: []*neo4j.Record{{}, {}}},
+		},
+		{
+			name:       "truncated by row limit",
+			result:     &database.ReadResult{Records: []*neo4j.Record{{}, {}}, Truncated: true, OmittedRows: 3},
+			wantNotice: "truncated to rows 1-2 of 5 (3 rows omitted) because it exceeded the maximum of 2 rows",
+		},
+		{
+			name:       "truncated by size limit",
+			result:     &database.ReadResult{Records: []*neo4j.Record{{}}, Truncated: true, OmittedRows: 1},
+			wantNotice: "truncated to rows 1-1 of 2 (1 rows omitted) because it exceeded the maximum response size of 1024 bytes",
+		},
+		{
+			name:       "truncated to no rows",
+			result:     &database.ReadResult{Records: []*neo4j.Record{}, Truncated: true, OmittedRows: 2},
+			wantNotice: "truncated to no rows (2 rows omitted) because row 1 alone exceeded the maximum response size of 1024 bytes",
+		},
+		{
+			name:       "truncated with more rows than counted",
+			result:     &database.ReadResult{Records: []*neo4j.Record{{}, {}}, Truncated: true, OmittedRows: 1000, MoreRows: true},
+			wantNotice: "truncated to rows 1-2 of more than 1002 (more than 1000 rows omitted) because it exceeded the maximum of 2 rows",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			mockDB.EXPECT().GetQueryType(gomock.Any(), query, gomock.Nil()).Return(neo4j.StatementTypeReadOnly, nil)
+			mockDB.EXPECT().
+				ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), hasReadOptions(database.ReadOptions{MaxRows: 2, MaxBytes: 1024})).
+				Return(tt.result, nil)
+
+			deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg}
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query}}}
+
+			result, err := cypher.ReadCypherHandler(deps)(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if result == nil || result.IsError {
+				t.Fatal("Expected success result")
+			}
+
+			if tt.wantNotice == "" {
+				if len(result.Content) != 1 {
+					t.Errorf("Expected 1 content, got %d", len(result.Content))
+				}
+				return
+			}
+			if len(result.Content) != 2 {
+				t.Fatalf("Expected records and truncation notice, got %d contents", len(result.Content))
+			}
+			notice, ok := result.Content[1].(mcp.TextContent)
+			if !ok || !strings.Contains(notice.Text, tt.wantNotice) {
+				t.Errorf("Expected notice containing %q, got %+v", tt.wantNotice, result.Content[1])
+			}
+		})
+	}
+}
+
+func TestReadCypherHandlerCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("read-cypher").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	query := "MATCH (n) RETURN n"
+	cfg := &config.Config{Database: "neo4j", AllowedDatabases: []string{"movies"}, MaxRows: 2}
+	cursors := cursor.NewStore(time.Minute, 0)
+
+	mockDB := db.NewMockService(ctrl)
+	mockDB.EXPECT().GetQueryType(gomock.Any(), query, gomock.Any()).Return(neo4j.StatementTypeReadOnly, nil)
+	mockDB.EXPECT().
+		ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Any(), gomock.Any()).
+		Return(&database.ReadResult{Records: []*neo4j.Record{{}, {}}, Truncated: true, OmittedRows: 3, Bookmarks: []string{"bookmark"}}, nil)
+
+	deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, Cursors: cursors}
+	request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"query":    query,
+		"params":   map[string]any{"limit": 10},
+		"database": "movies",
+		"timeout":  45,
+	}}}
+
+	result, err := cypher.ReadCypherHandler(deps)(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result == nil || result.IsError || len(result.Content) != 2 {
+		t.Fatalf("Expected records and truncation notice, got %+v", result)
+	}
+
+	notice := result.Content[1].(mcp.TextContent).Text
+	match := regexp.MustCompile(`fetch-more with cursor "([^"]+)"`).FindStringSubmatch(notice)
+	if match == nil {
+		t.Fatalf("Expected notice with a cursor, got %q", notice)
+	}
+
+	c, err := cursors.Get("", match[1])
+	if err != nil {
+		t.Fatalf("Expected an open cursor, got: %v", err)
+	}
+	if c.Query != query || c.Database != "movies" || c.Offset != 2 || c.Params["limit"] != int64(10) || len(c.Bookmarks) != 1 || c.Timeout != 45 {
+		t.Errorf("Unexpected cursor %+v", c)
+	}
+}
+
+func TestReadCypherHandlerNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("read-cypher").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	query := "MATCH (a), (b) RETURN a, b"
+	tests := []struct {
+		name        string
+		summary     database.Summary
+		wantSummary string // empty when no summary must follow the records
+	}{
+		{
+			name:    "no notifications",
+			summary: database.Summary{ResultAvailableAfter: time.Millisecond},
+		},
+		{
+			name: "performance notification",
+			summary: database.Summary{
+				Notifications: []database.Notification{
+					{Code: "Neo.ClientNotification.Statement.CartesianProduct", Title: "Cartesian product", Description: "This query builds a cartesian product.", Severity: "WARNING", Category: "PERFORMANCE"},
+				},
+				ResultAvailableAfter: 4 * time.Millisecond,
+				ResultConsumedAfter:  -1,
+			},
+			wantSummary: `Result summary: {"notifications":[{"code":"Neo.ClientNotification.Statement.CartesianProduct","title":"Cartesian product",` +
+				`"description":"This query builds a cartesian product.","severity":"WARNING","category":"PERFORMANCE"}],"resultAvailableAfterMs":4}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			mockDB.EXPECT().GetQueryType(gomock.Any(), query, gomock.Nil()).Return(neo4j.StatementTypeReadOnly, nil)
+			mockDB.EXPECT().ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), gomock.Any()).
+				Return(&database.ReadResult{Records: []*neo4j.Record{}, Summary: tt.summary}, nil)
+			deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService}
+
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query}}}
+			result, err := cypher.ReadCypherHandler(deps)(context.Background(), request)
+			if err != nil || result.IsError {
+				t.Fatalf("Expected success, got: %+v, %v", result, err)
+			}
+
+			if tt.wantSummary == "" {
+				if len(result.Content) != 1 {
+					t.Errorf("Expected only the records, got %+v", result.Content)
+				}
+				return
+			}
+			if len(result.Content) != 2 {
+				t.Fatalf("Expected the records followed by the summary, got %+v", result.Content)
+			}
+			if got := result.Content[1].(mcp.TextContent).Text; got != tt.wantSummary {
+				t.Errorf("Expected summary:\n%s\ngot:\n%s", tt.wantSummary, got)
+			}
+		})
+	}
+}
+
+func TestReadCypherHandlerFormat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent(gomock.Any()).AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	query := "MATCH (n:Person) RETURN n.name AS name, n.age AS age"
+	keys := []string{"name", "age"}
+	result := func() *database.ReadResult {
+		return &database.ReadResult{Keys: keys, Records: []*neo4j.Record{
+			{Keys: keys, Values: []any{"Alice", int64(30)}},
+			{Keys: keys, Values: []any{"Bob", int64(25)}},
+		}}
+	}
+
+	tests := []struct {
+		name          string
+		defaultFormat string
+		format        string
+		want          string
+	}{
+		{name: "server default", defaultFormat: "csv", want: "name,age\nAlice,30\nBob,25\n"},
+		{name: "requested format", defaultFormat: "csv", format: "json-compact", want: `{"columns":["name","age"],"rows":[["Alice",30],["Bob",25]]}`},
+		{name: "markdown", format: "markdown", want: "| name | age |\n| --- | --- |\n| Alice | 30 |\n| Bob | 25 |"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			mockDB.EXPECT().GetQueryType(gomock.Any(), query, gomock.Nil()).Return(neo4j.StatementTypeReadOnly, nil)
+			mockDB.EXPECT().ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), gomock.Any()).Return(result(), nil)
+			deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: &config.Config{ResultFormat: tt.defaultFormat}}
+
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query, "format": tt.format}}}
+			got, err := cypher.ReadCypherHandler(deps)(context.Background(), request)
+			if err != nil || got.IsError {
+				t.Fatalf("Expected success, got: %+v, %v", got, err)
+			}
+			if text := got.Content[0].(mcp.TextContent).Text; text != tt.want {
+				t.Errorf("Expected:\n%s\ngot:\n%s", tt.want, text)
+			}
+		})
+	}
+
+	t.Run("fetch-more keeps the format of the query", func(t *testing.T) {
+		cfg := &config.Config{MaxRows: 1, ResultFormat: "json"}
+		cursors := cursor.NewStore(time.Minute, 0)
+		truncated := result()
+		truncated.Records, truncated.Truncated, truncated.OmittedRows = truncated.Records[:1], true, 1
+		last := result()
+		last.Records = last.Records[1:]
+
+		var recordSize func(*neo4j.Record) int
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().GetQueryType(gomock.Any(), query, gomock.Nil()).Return(neo4j.StatementTypeReadOnly, nil)
+		mockDB.EXPECT().ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ map[string]any, opts database.ReadOptions) (*database.ReadResult, error) {
+				recordSize = opts.RecordSize
+				return truncated, nil
+			})
+		mockDB.EXPECT().ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), gomock.Any()).Return(last, nil)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, Cursors: cursors}
+
+		request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query, "format": "csv"}}}
+		first, err := cypher.ReadCypherHandler(deps)(context.Background(), request)
+		if err != nil || first.IsError || len(first.Content) != 2 {
+			t.Fatalf("Expected records and truncation notice, got: %+v, %v", first, err)
+		}
+		if recordSize == nil || recordSize(truncated.Records[0]) != len("Alice,30\n") {
+			t.Error("Expected the size limit to be checked against CSV records")
+		}
+
+		id := regexp.MustCompile(`fetch-more with cursor "([^"]+)"`).FindStringSubmatch(first.Content[1].(mcp.TextContent).Text)
+		if id == nil {
+			t.Fatalf("Expected notice with a cursor, got %+v", first.Content[1])
+		}
+		next, err := cypher.FetchMoreHandler(deps)(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"cursor": id[1]}}})
+		if err != nil || next.IsError {
+			t.Fatalf("Expected success, got: %+v, %v", next, err)
+		}
+		if text := next.Content[0].(mcp.TextContent).Text; text != "name,age\nBob,25\n" {
+			t.Errorf("Expected the next page as CSV, got:\n%s", text)
+		}
+	})
+}
+
+func TestReadCypherHandlerTokenBudget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent(gomock.Any()).AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	query := "MATCH (n:Person) RETURN n.bio AS bio"
+	records := func(n int) *database.ReadResult {
+		result := &database.ReadResult{Keys: []string{"bio"}}
+		for range n {
+			result.Records = append(result.Records, &neo4j.Record{Keys: []string{"bio"}, Values: []any{strings.Repeat("a", 400)}})
+		}
+		return result
+	}
+
+	t.Run("previews long strings", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().GetQueryType(gomock.Any(), query, gomock.Nil()).Return(neo4j.StatementTypeReadOnly, nil)
+		mockDB.EXPECT().ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), gomock.Any()).Return(records(2), nil)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: &config.Config{MaxTokens: 200}}
+
+		request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query, "format": "csv"}}}
+		result, err := cypher.ReadCypherHandler(deps)(context.Background(), request)
+		if err != nil || result.IsError || len(result.Content) != 2 {
+			t.Fatalf("Expected the records followed by the budget report, got: %+v, %v", result, err)
+		}
+		want := `Token budget: {"maxTokens":200,"estimatedTokens":`
+		report := result.Content[1].(mcp.TextContent).Text
+		if !strings.HasPrefix(report, want) || !strings.HasSuffix(report, `"elided":[{"path":"bio","action":"previewed","count":2}]}`) {
+			t.Errorf("Expected the previewed strings to be reported, got %s", report)
+		}
+	})
+
+	t.Run("leaves out rows and opens a cursor", func(t *testing.T) {
+		cursors := cursor.NewStore(time.Minute, 0)
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().GetQueryType(gomock.Any(), query, gomock.Nil()).Return(neo4j.StatementTypeReadOnly, nil)
+		mockDB.EXPECT().ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), gomock.Any()).Return(records(10), nil)
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: &config.Config{}, Cursors: cursors}
+
+		request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query, "format": "csv", "max_tokens": 60}}}
+		result, err := cypher.ReadCypherHandler(deps)(context.Background(), request)
+		if err != nil || result.IsError || len(result.Content) != 3 {
+			t.Fatalf("Expected the records, the truncation notice and the budget report, got: %+v, %v", result, err)
+		}
+
+		notice := result.Content[1].(mcp.TextContent).Text
+		if !strings.Contains(notice, "(8 rows omitted) because it exceeded the budget of 60 tokens") {
+			t.Errorf("Expected a notice for the rows left out, got %s", notice)
+		}
+		if report := result.Content[2].(mcp.TextContent).Text; !strings.Contains(report, `"omittedRows":8`) {
+			t.Errorf("Expected the budget report to count the rows left out, got %s", report)
+		}
+		id := regexp.MustCompile(`fetch-more with cursor "([^"]+)"`).FindStringSubmatch(notice)
+		if id == nil {
+			t.Fatalf("Expected notice with a cursor, got %q", notice)
+		}
+		c, err := cursors.Get("", id[1])
+		if err != nil || c.Offset != 2 || c.MaxTokens != 60 {
+			t.Errorf("Expected a cursor after the rows returned keeping the budget, got %+v, %v", c, err)
+		}
+	})
+
+	t.Run("negative budget", func(t *testing.T) {
+		deps := &tools.ToolDependencies{DBService: db.NewMockService(ctrl), AnalyticsService: analyticsService}
+		request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query, "max_tokens": -1}}}
+		result, err := cypher.ReadCypherHandler(deps)(context.Background(), request)
+		if err != nil || result == nil || !result.IsError {
+			t.Errorf("Expected an error result, got: %+v, %v", result, err)
+		}
+	})
+}
+
+func TestReadCypherHandlerVectors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent(gomock.Any()).AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	query := "MATCH (n:Doc) RETURN n"
+	embedding := make([]any, 1536)
+	for i := range embedding {
+		embedding[i] = 0.5
+	}
+	node := neo4j.Node{ElementId: "4:db:1", Labels: []string{"Doc"}, Props: map[string]any{"title": "Intro", "embedding": embedding}}
+	cfg := &config.Config{VectorMinDimensions: 128}
+
+	tests := []struct {
+		name string
+		raw  bool
+		want string
+	}{
+		{name: "elides vectors", want: `{"columns":["n"],"rows":[[{"elementId":"4:db:1","labels":["Doc"],"properties":{"embedding":{"dims":1536,"type":"vector"},"title":"Intro"}}]]}`},
+		{name: "returns raw vectors on request", raw: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recordSize func(*neo4j.Record) int
+			mockDB := db.NewMockService(ctrl)
+			mockDB.EXPECT().GetQueryType(gomock.Any(), query, gomock.Nil()).Return(neo4j.StatementTypeReadOnly, nil)
+			mockDB.EXPECT().ExecuteReadQueryWithOptions(gomock.Any(), query, gomock.Nil(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ map[string]any, opts database.ReadOptions) (*database.ReadResult, error) {
+					recordSize = opts.RecordSize
+					return &database.ReadResult{Keys: []string{"n"}, Records: []*neo4j.Record{{Keys: []string{"n"}, Values: []any{node}}}}, nil
+				})
+			deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg}
+
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query, "format": "json-compact", "raw_vectors": tt.raw}}}
+			result, err := cypher.ReadCypherHandler(deps)(context.Background(), request)
+			if err != nil || result.IsError {
+				t.Fatalf("Expected success, got: %+v, %v", result, err)
+			}
+
+			text := result.Content[0].(mcp.TextContent).Text
+			size := recordSize(&neo4j.Record{Keys: []string{"n"}, Values: []any{node}})
+			if tt.raw {
+				if strings.Contains(text, `"type":"vector"`) || size < 1536*4 {
+					t.Errorf("Expected the raw vector of %d bytes, got %d bytes: %.200s", 1536*4, size, text)
+				}
+				return
+			}
+			if text != tt.want {
+				t.Errorf("Expected:\n%s\ngot:\n%s", tt.want, text)
+			}
+			if size > 200 {
+				t.Errorf("Expected the size limit to be checked against the elided record, got %d bytes", size)
+			}
+		})
+	}
+}
@@ -5,8 +5,13 @@ import (
 )
 
 type ReadCypherInput struct {
-	Query  string         `json:"query" jsonschema:"default=MATCH(n) RETURN n,description=The Cypher query to execute"`
-	Params map[string]any `json:"params" jsonschema:"default={},description=Parameters to pass to the Cypher query"`
+	Query      string         `json:"query" jsonschema:"default=MATCH(n) RETURN n,description=The Cypher query to execute"`
+	Params     map[string]any `json:"params" jsonschema:"default={},description=Parameters to pass to the Cypher query"`
+	Database   string         `json:"database,omitempty" jsonschema:"description=Name of the database to run the query against, defaults to the configured database. Use list-databases to find the available databases"`
+	Timeout    float64        `json:"timeout,omitempty" jsonschema:"description=Timeout of the query in seconds, after which Neo4j terminates it. Defaults to the server query timeout"`
+	Format     string         `json:"format,omitempty" jsonschema:"description=Format of the returned records: json (array of objects keyed by column) or json-compact (columns and rows arrays using fewer tokens) or csv or markdown (table). Defaults to the server result format"`
+	MaxTokens  int            `json:"max_tokens,omitempty" jsonschema:"description=Approximate budget of tokens for the returned records. Over budget long strings are previewed and long lists summarized and large property values dropped then trailing rows are left out. The elided values are reported after the records. Defaults to the server token budget"`
+	RawVectors bool           `json:"raw_vectors,omitempty" jsonschema:"description=Return embedding vectors as is. By default large numeric lists and the configured vector properties are replaced with a vector placeholder holding their dimensions"`
 }
 
 // GetParams returns the params map
@@ -21,7 +26,8 @@ func (r *ReadCypherInput) SetParams(params map[string]any) {
 
 func ReadCypherSpec() mcp.Tool {
 	return mcp.NewTool("read-cypher",
-		mcp.WithDescription("read-cypher can run only read-only Cypher statements. For write operations (CREATE, MERGE, DELETE, SET, etc...), schema/admin commands, or PROFILE queries, use write-cypher instead."),
+		mcp.WithDescription("read-cypher can run only read-only Cypher statements. For write operations (CREATE, MERGE, DELETE, SET, etc...) or schema/admin commands, use write-cypher instead. To profile a query, use profile-cypher. "+
+			"Large results are truncated and paginated with fetch-more, which runs the query again: add an ORDER BY on unique values to queries whose results you will page through, so that pages neither repeat nor miss rows."),
 		mcp.WithInputSchema[ReadCypherInput](),
 		mcp.WithTitleAnnotation("Read Cypher"),
 		mcp.WithReadOnlyHintAnnotation(true),
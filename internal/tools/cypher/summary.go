@@ -0,0 +1,51 @@
+package cypher
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/database"
+)
+
+// summaryPrefix introduces the result summary following the records of a tool result
+const summaryPrefix = "Result summary: "
+
+// resultSummary is the summary of a query reported next to its records
+type resultSummary struct {
+	// Counters are the updates made by a write query, nil for read queries
+	Counters               *database.Counters      `json:"counters,omitempty"`
+	Notifications          []database.Notification `json:"notifications,omitempty"`
+	ResultAvailableAfterMs int64                   `json:"resultAvailableAfterMs,omitempty"`
+	ResultConsumedAfterMs  int64                   `json:"resultConsumedAfterMs,omitempty"`
+}
+
+// withSummary appends the summary of a query to its tool result, as JSON following summaryPrefix.
+// Read queries, whose counters are nil, only get a summary when the server reported notifications.
+func withSummary(result *mcp.CallToolResult, counters *database.Counters, summary database.Summary) *mcp.CallToolResult {
+	if counters == nil && len(summary.Notifications) == 0 {
+		return result
+	}
+
+	formatted, err := json.Marshal(resultSummary{
+		Counters:               counters,
+		Notifications:          summary.Notifications,
+		ResultAvailableAfterMs: milliseconds(summary.ResultAvailableAfter),
+		ResultConsumedAfterMs:  milliseconds(summary.ResultConsumedAfter),
+	})
+	if err != nil {
+		log.Printf("Error formatting result summary: %v", err)
+		return result
+	}
+	result.Content = append(result.Content, mcp.NewTextContent(summaryPrefix+string(formatted)))
+	return result
+}
+
+// milliseconds returns d in milliseconds, 0 when it was not reported
+func milliseconds(d time.Duration) int64 {
+	if d < 0 {
+		return 0
+	}
+	return d.Milliseconds()
+}
@@ -7,17 +7,20 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
 	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/format"
+	"github.com/neo4j/mcp/internal/schemacache"
 	"github.com/neo4j/mcp/internal/tools"
 )
 
 func WriteCypherHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		return handleWriteCypher(ctx, request, deps.DBService, deps.AnalyticsService)
+		return handleWriteCypher(ctx, request, deps.DBService, deps.AnalyticsService, deps.Config, deps.SchemaCache)
 	}
 }
 
-func handleWriteCypher(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service) (*mcp.CallToolResult, error) {
+func handleWriteCypher(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service, cfg *config.Config, cache *schemacache.Cache) (*mcp.CallToolResult, error) {
 	if asService == nil {
 		errMessage := "Analytics service is not initialized"
 		log.Printf("%s", errMessage)
@@ -58,18 +61,46 @@ func handleWriteCypher(ctx context.Context, request mcp.CallToolRequest, dbServi
 		return mcp.NewToolResultError(errMessage), nil
 	}
 
+	ctx, err := tools.DatabaseContext(ctx, cfg, args.Database)
+	if err != nil {
+		log.Printf("Rejected database %q: %v", args.Database, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ctx, err = tools.QueryTimeoutContext(ctx, cfg, args.Timeout)
+	if err != nil {
+		log.Printf("Rejected timeout %v: %v", args.Timeout, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	formatter, err := recordFormatter(cfg, args.Format)
+	if err != nil {
+		log.Printf("Rejected format %q: %v", args.Format, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	maxTokens, err := tokenBudget(cfg, args.MaxTokens)
+	if err != nil {
+		log.Printf("Rejected token budget %d: %v", args.MaxTokens, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	// Execute the Cypher query using the database service
-	records, err := dbService.ExecuteWriteQuery(ctx, Query, Params)
+	result, err := dbService.ExecuteWriteQueryWithCounters(ctx, Query, Params)
 	if err != nil {
 		log.Printf("Error executing Cypher query: %v", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	response, err := dbService.Neo4jRecordsToJSON(records)
+	if cache != nil && result.Counters.SchemaChanged() {
+		cache.Invalidate(tools.DatabaseName(ctx, cfg))
+	}
+
+	budget, err := format.FormatWithinBudget(formatter, result.Keys, database.ElideVectors(result.Records, vectorOptions(cfg, args.RawVectors)), maxTokens)
 	if err != nil {
 		log.Printf("Error formatting query results: %v", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	return mcp.NewToolResultText(response), nil
+	return withSummary(withBudgetReport(mcp.NewToolResultText(budget.Text), budget, maxTokens), &result.Counters, result.Summary), nil
 }
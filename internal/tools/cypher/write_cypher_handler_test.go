@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/database"
 	db "github.com/neo4j/mcp/internal/database/mocks"
 	"github.com/neo4j/mcp/internal/tools"
 	"github.com/neo4j/mcp/internal/tools/cypher"
@@ -24,11 +26,8 @@ func TestWriteCypherHandler(t *testing.T) {
 	t.Run("successful cypher execution with parameters", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 		mockDB.EXPECT().
-			ExecuteWriteQuery(gomock.Any(), "MATCH (n:Person {name: $name}) RETURN n", map[string]any{"name": "Alice"}).
-			Return([]*neo4j.Record{}, nil)
-		mockDB.EXPECT().
-			Neo4jRecordsToJSON(gomock.Any()).
-			Return(`[{"n": {"name": "Alice"}}]`, nil)
+			ExecuteWriteQueryWithCounters(gomock.Any(), "MATCH (n:Person {name: $name}) RETURN n", map[string]any{"name": "Alice"}).
+			Return(&database.WriteResult{}, nil)
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
@@ -58,11 +57,8 @@ func TestWriteCypherHandler(t *testing.T) {
 	t.Run("successful cypher execution without parameters", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 		mockDB.EXPECT().
-			ExecuteWriteQuery(gomock.Any(), "MATCH (n) RETURN count(n)", gomock.Nil()).
-			Return([]*neo4j.Record{}, nil)
-		mockDB.EXPECT().
-			Neo4jRecordsToJSON(gomock.Any()).
-			Return(`[{"count(n)": 42}]`, nil)
+			ExecuteWriteQueryWithCounters(gomock.Any(), "MATCH (n) RETURN count(n)", gomock.Nil()).
+			Return(&database.WriteResult{}, nil)
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
@@ -116,7 +112,7 @@ func TestWriteCypherHandler(t *testing.T) {
 
 	t.Run("missing required arguments", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
-		// The handler should NOT call ExecuteWriteQuery when query is empty
+		// The handler should NOT call ExecuteWriteQueryWithCounters when query is empty
 		// No expectations set for mockDB since it shouldn't be called
 
 		deps := &tools.ToolDependencies{
@@ -146,7 +142,7 @@ func TestWriteCypherHandler(t *testing.T) {
 
 	t.Run("empty query parameter", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
-		// The handler should NOT call ExecuteWriteQuery when query is empty
+		// The handler should NOT call ExecuteWriteQueryWithCounters when query is empty
 		// No expectations set for mockDB since it shouldn't be called
 
 		deps := &tools.ToolDependencies{
@@ -219,7 +215,7 @@ func TestWriteCypherHandler(t *testing.T) {
 	t.Run("database query execution failure", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 		mockDB.EXPECT().
-			ExecuteWriteQuery(gomock.Any(), "INVALID CYPHER", gomock.Nil()).
+			ExecuteWriteQueryWithCounters(gomock.Any(), "INVALID CYPHER", gomock.Nil()).
 			Return(nil, errors.New("syntax error"))
 
 		deps := &tools.ToolDependencies{
@@ -246,14 +242,8 @@ func TestWriteCypherHandler(t *testing.T) {
 		}
 	})
 
-	t.Run("JSON formatting failure", func(t *testing.T) {
+	t.Run("unknown format", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
-		mockDB.EXPECT().
-			ExecuteWriteQuery(gomock.Any(), "MATCH (n) RETURN n", gomock.Nil()).
-			Return([]*neo4j.Record{}, nil)
-		mockDB.EXPECT().
-			Neo4jRecordsToJSON(gomock.Any()).
-			Return("", errors.New("JSON marshaling failed"))
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
@@ -264,7 +254,8 @@ func TestWriteCypherHandler(t *testing.T) {
 		request := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Arguments: map[string]any{
-					"query": "MATCH (n) RETURN n",
+					"query":  "MATCH (n) RETURN n",
+					"format": "xml",
 				},
 			},
 		}
@@ -275,7 +266,7 @@ func TestWriteCypherHandler(t *testing.T) {
 			t.Errorf("Expected no error from handler, got: %v", err)
 		}
 		if result == nil || !result.IsError {
-			t.Error("Expected error result for JSON formatting failure")
+			t.Error("Expected error result for unknown format")
 		}
 	})
 }
@@ -291,8 +282,7 @@ func TestWriteCypherHandlerEvents(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 
 		query := "CALL gds.graph.project('myGraph', 'Node', 'REL')"
-		mockDB.EXPECT().ExecuteWriteQuery(gomock.Any(), query, gomock.Nil()).Return([]*neo4j.Record{}, nil)
-		mockDB.EXPECT().Neo4jRecordsToJSON(gomock.Any()).Return("[]", nil)
+		mockDB.EXPECT().ExecuteWriteQueryWithCounters(gomock.Any(), query, gomock.Nil()).Return(&database.WriteResult{}, nil)
 
 		analyticServiceExplicitMock := analytics.NewMockService(ctrl)
 		analyticServiceExplicitMock.EXPECT().NewGDSProjCreatedEvent().Times(1)
@@ -327,8 +317,7 @@ func TestWriteCypherHandlerEvents(t *testing.T) {
 		analyticServiceExplicitMock := analytics.NewMockService(ctrl)
 
 		query := "CALL gds.graph.drop('myGraph')"
-		mockDB.EXPECT().ExecuteWriteQuery(gomock.Any(), query, gomock.Nil()).Return([]*neo4j.Record{}, nil)
-		mockDB.EXPECT().Neo4jRecordsToJSON(gomock.Any()).Return("[]", nil)
+		mockDB.EXPECT().ExecuteWriteQueryWithCounters(gomock.Any(), query, gomock.Nil()).Return(&database.WriteResult{}, nil)
 
 		analyticServiceExplicitMock.EXPECT().NewGDSProjDropEvent().Times(1)
 		analyticServiceExplicitMock.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
@@ -354,3 +343,60 @@ func TestWriteCypherHandlerEvents(t *testing.T) {
 		}
 	})
 }
+
+func TestWriteCypherHandlerSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("write-cypher").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	query := "CREATE (n:Person {name: 'Alice'})"
+	mockDB := db.NewMockService(ctrl)
+	mockDB.EXPECT().ExecuteWriteQueryWithCounters(gomock.Any(), query, gomock.Nil()).Return(&database.WriteResult{
+		Counters: database.Counters{NodesCreated: 1, LabelsAdded: 1, PropertiesSet: 1},
+		Summary:  database.Summary{ResultAvailableAfter: 5 * time.Millisecond, ResultConsumedAfter: 1 * time.Millisecond},
+	}, nil)
+	deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService}
+
+	request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query}}}
+	result, err := cypher.WriteCypherHandler(deps)(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("Expected success, got: %+v, %v", result, err)
+	}
+	if len(result.Content) != 2 {
+		t.Fatalf("Expected the records followed by the summary, got %+v", result.Content)
+	}
+
+	want := `Result summary: {"counters":{"nodesCreated":1,"propertiesSet":1,"labelsAdded":1},"resultAvailableAfterMs":5,"resultConsumedAfterMs":1}`
+	if got := result.Content[1].(mcp.TextContent).Text; got != want {
+		t.Errorf("Expected summary:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestWriteCypherHandlerFormat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("write-cypher").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	query := "CREATE (n:Person {name: 'Alice'}) RETURN n.name AS name"
+	mockDB := db.NewMockService(ctrl)
+	mockDB.EXPECT().ExecuteWriteQueryWithCounters(gomock.Any(), query, gomock.Nil()).Return(&database.WriteResult{
+		Keys:     []string{"name"},
+		Records:  []*neo4j.Record{{Keys: []string{"name"}, Values: []any{"Alice"}}},
+		Counters: database.Counters{NodesCreated: 1},
+	}, nil)
+	deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService}
+
+	request := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query, "format": "json-compact"}}}
+	result, err := cypher.WriteCypherHandler(deps)(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("Expected success, got: %+v, %v", result, err)
+	}
+	want := `{"columns":["name"],"rows":[["Alice"]]}`
+	if text := result.Content[0].(mcp.TextContent).Text; text != want {
+		t.Errorf("Expected %s, got %s", want, text)
+	}
+}
@@ -0,0 +1,52 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+)
+
+// DatabaseContext returns a copy of ctx targeting the named database, when it is allowed by the configuration.
+// An empty name keeps the configured default database.
+func DatabaseContext(ctx context.Context, cfg *config.Config, name string) (context.Context, error) {
+	if name == "" {
+		return ctx, nil
+	}
+	if cfg == nil || !cfg.DatabaseAllowed(name) {
+		return nil, fmt.Errorf("database %q is not allowed, use list-databases to find the databases available to this server", name)
+	}
+	return database.WithDatabase(ctx, name), nil
+}
+
+// DatabaseName returns the name of the database targeted by ctx, the configured default database when it targets none.
+// Database names are case-insensitive, the name is returned in lower case.
+func DatabaseName(ctx context.Context, cfg *config.Config) string {
+	if name, ok := database.DatabaseFromContext(ctx); ok {
+		return strings.ToLower(name)
+	}
+	if cfg == nil {
+		return ""
+	}
+	return strings.ToLower(cfg.Database)
+}
+
+// QueryTimeoutContext returns a copy of ctx setting the transaction timeout requested by a tool call, in seconds,
+// when it does not exceed the maximum of the configuration. A zero timeout keeps the default query timeout.
+func QueryTimeoutContext(ctx context.Context, cfg *config.Config, seconds float64) (context.Context, error) {
+	if seconds == 0 {
+		return ctx, nil
+	}
+	if seconds < 0 {
+		return nil, fmt.Errorf("timeout must be a positive number of seconds but was %v", seconds)
+	}
+
+	timeout := time.Duration(seconds * float64(time.Second))
+	if cfg != nil && cfg.MaxQueryTimeout > 0 && timeout > cfg.MaxQueryTimeout {
+		return nil, fmt.Errorf("timeout of %s exceeds the maximum of %s", timeout, cfg.MaxQueryTimeout)
+	}
+	return database.WithTxTimeout(ctx, timeout), nil
+}
@@ -0,0 +1,20 @@
+package tools
+
+import (
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/format"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// FormatRecords formats records in the result format of the configuration, JSON when none is configured
+func FormatRecords(cfg *config.Config, records []*neo4j.Record) (string, error) {
+	name := ""
+	if cfg != nil {
+		name = cfg.ResultFormat
+	}
+	formatter, err := format.Get(name)
+	if err != nil {
+		return "", err
+	}
+	return formatter.Format(nil, records)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
 	"github.com/neo4j/mcp/internal/database"
 	"github.com/neo4j/mcp/internal/tools"
 )
@@ -20,11 +21,11 @@ RETURN name, description, signature, type`
 
 func ListGdsProceduresHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		return handleListGdsProcedures(ctx, deps.DBService, deps.AnalyticsService)
+		return handleListGdsProcedures(ctx, deps.DBService, deps.AnalyticsService, deps.Config)
 	}
 }
 
-func handleListGdsProcedures(ctx context.Context, dbService database.Service, asService analytics.Service) (*mcp.CallToolResult, error) {
+func handleListGdsProcedures(ctx context.Context, dbService database.Service, asService analytics.Service, cfg *config.Config) (*mcp.CallToolResult, error) {
 	if dbService == nil {
 		errMessage := "Database service is not initialized"
 		log.Printf("%s", errMessage)
@@ -45,9 +46,9 @@ func handleListGdsProcedures(ctx context.Context, dbService database.Service, as
 		return mcp.NewToolResultError(formattedErrorMessage.Error()), nil
 	}
 
-	response, err := dbService.Neo4jRecordsToJSON(records)
+	response, err := tools.FormatRecords(cfg, records)
 	if err != nil {
-		log.Printf("Failed to format list-gds-procedures results to JSON: %v", err)
+		log.Printf("Failed to format list-gds-procedures results: %v", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
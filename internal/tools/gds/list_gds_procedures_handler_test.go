@@ -7,6 +7,7 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
 	db "github.com/neo4j/mcp/internal/database/mocks"
 	"github.com/neo4j/mcp/internal/tools"
 	"github.com/neo4j/mcp/internal/tools/gds"
@@ -26,9 +27,6 @@ func TestListGdsProceduresHandler(t *testing.T) {
 		mockDB.EXPECT().
 			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
 			Return([]*neo4j.Record{}, nil)
-		mockDB.EXPECT().
-			Neo4jRecordsToJSON(gomock.Any()).
-			Return("", nil)
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
@@ -108,19 +106,17 @@ func TestListGdsProceduresHandler(t *testing.T) {
 		}
 	})
 
-	t.Run("JSON formatting failure", func(t *testing.T) {
+	t.Run("formatting failure", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 
 		mockDB.EXPECT().
 			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
 			Return([]*neo4j.Record{}, nil)
-		mockDB.EXPECT().
-			Neo4jRecordsToJSON(gomock.Any()).
-			Return("", errors.New("JSON marshaling failed"))
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
 			AnalyticsService: analyticsService,
+			Config:           &config.Config{ResultFormat: "yaml"},
 		}
 
 		handler := gds.ListGdsProceduresHandler(deps)
@@ -132,7 +128,7 @@ func TestListGdsProceduresHandler(t *testing.T) {
 			t.Errorf("Expected no error from handler, got: %v", err)
 		}
 		if result == nil || !result.IsError {
-			t.Error("Expected error result for JSON formatting failure")
+			t.Error("Expected error result for formatting failure")
 		}
 	})
 }
@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/schema"
+	"github.com/neo4j/mcp/internal/schemacache"
+)
+
+// ReadSchema returns the schema of the database targeted by ctx, read with the strategy of the configuration.
+// When cache is not nil, the schema is read from it and cached when there is none, it expired or refresh is set;
+// schemas are cached per Neo4j credentials, strategy and options.
+func ReadSchema(ctx context.Context, dbService database.Service, cfg *config.Config, cache *schemacache.Cache, opts schema.Options, refresh bool) (*schema.Schema, error) {
+	strategy := config.SchemaStrategyAuto
+	if cfg != nil {
+		strategy = cfg.SchemaStrategy
+	}
+
+	credentials, identified := neo4jCredentials(ctx)
+	if cache == nil || !identified {
+		return schema.Introspect(ctx, dbService, strategy, opts)
+	}
+
+	name := DatabaseName(ctx, cfg)
+	variant := fmt.Sprintf("%s|%s|%+v", credentials, strategy, opts)
+	cached, generation, ok := cache.Get(name, variant)
+	if ok && !refresh {
+		return cached, nil
+	}
+
+	graphSchema, err := schema.Introspect(ctx, dbService, strategy, opts)
+	if err != nil {
+		return nil, err
+	}
+	cache.Put(name, variant, generation, graphSchema)
+	return graphSchema, nil
+}
+
+// neo4jCredentials returns a hash of the whole auth token queries run with when ctx carries per-request credentials, empty otherwise.
+// Hashing the secret along with the user makes sure a cached schema is only served to the credentials Neo4j accepted when reading it.
+// It reports false for credentials that do not name their user, such as bearer tokens, whose schema must not be shared.
+func neo4jCredentials(ctx context.Context) (string, bool) {
+	token, ok := database.AuthTokenFromContext(ctx)
+	if !ok {
+		return "", true
+	}
+	if principal, ok := token.Tokens["principal"].(string); !ok || principal == "" {
+		return "", false
+	}
+	// The keys of the token are sorted by json.Marshal, so equal tokens have equal hashes
+	formatted, err := json.Marshal(token.Tokens)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(formatted)
+	return hex.EncodeToString(sum[:]), true
+}
@@ -0,0 +1,108 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+)
+
+// FulltextSearchHandler returns a handler function for the fulltext-search tool
+func FulltextSearchHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleFulltextSearch(ctx, request, deps.DBService, deps.AnalyticsService, deps.Config)
+	}
+}
+
+// handleFulltextSearch queries a fulltext index for the nodes or relationships matching a Lucene query
+func handleFulltextSearch(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if asService == nil {
+		errMessage := "Analytics service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if dbService == nil {
+		errMessage := "Database service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	asService.EmitEvent(asService.NewToolsEvent("fulltext-search"))
+
+	var args FulltextSearchInput
+	if err := cypher.BindArguments(request, &args); err != nil {
+		log.Printf("Error binding arguments: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if args.Index == "" {
+		errMessage := "Index parameter is required and cannot be empty"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if strings.TrimSpace(args.Query) == "" {
+		errMessage := "Query parameter is required and cannot be empty"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	limit, err := resultLimit(cfg, "limit", args.Limit)
+	if err != nil {
+		log.Printf("Rejected limit %d: %v", args.Limit, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ctx, err = tools.DatabaseContext(ctx, cfg, args.Database)
+	if err != nil {
+		log.Printf("Rejected database %q: %v", args.Database, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	entityType, _, err := lookupIndex(ctx, dbService, "fulltext", args.Index)
+	if err != nil {
+		log.Printf("Failed to look up fulltext index %q: %v", args.Index, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	query, keys := fulltextSearchQuery(entityType, args.Properties)
+	records, err := dbService.ExecuteReadQuery(ctx, query, map[string]any{"index": args.Index, "query": args.Query, "limit": limit})
+	if err != nil {
+		log.Printf("Failed to execute fulltext-search query: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	response, err := formatRecords(cfg, keys, database.ElideVectors(records, vectorOptions(cfg)))
+	if err != nil {
+		log.Printf("Failed to format fulltext-search results: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(response), nil
+}
+
+// fulltextSearchQuery returns the query searching an index of entityType, returning only the given properties of the results
+// when any are selected, and its columns
+func fulltextSearchQuery(entityType string, properties []string) (string, []string) {
+	procedure, variable := "db.index.fulltext.queryNodes", "node"
+	if entityType == relationshipIndex {
+		procedure, variable = "db.index.fulltext.queryRelationships", "relationship"
+	}
+
+	query := fmt.Sprintf("CALL %s($index, $query, {limit: $limit}) YIELD %s, score\n", procedure, variable)
+	if len(properties) == 0 {
+		return query + fmt.Sprintf("RETURN %s, score\nORDER BY score DESC\nLIMIT $limit", variable), []string{variable, "score"}
+	}
+
+	selectors := make([]string, len(properties))
+	for i, property := range properties {
+		selectors[i] = "." + quoteIdentifier(property)
+	}
+	return query + fmt.Sprintf("RETURN elementId(%s) AS elementId, %s {%s} AS properties, score\nORDER BY score DESC\nLIMIT $limit",
+		variable, variable, strings.Join(selectors, ", ")), []string{"elementId", "properties", "score"}
+}
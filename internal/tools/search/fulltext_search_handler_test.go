@@ -0,0 +1,156 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/search"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+// isFulltextIndexLookup matches the query looking up the fulltext index searched
+func isFulltextIndexLookup() gomock.Matcher {
+	return gomock.Cond(func(query string) bool { return strings.Contains(query, "SHOW FULLTEXT INDEXES") })
+}
+
+// fulltextIndexRecords returns the lookup result of a fulltext index of entityType
+func fulltextIndexRecords(entityType string) []*neo4j.Record {
+	return []*neo4j.Record{{Keys: []string{"entityType", "properties"}, Values: []any{entityType, []any{"name"}}}}
+}
+
+func TestFulltextSearchHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("fulltext-search").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Database: "neo4j", MaxRows: 100}
+	request := func(arguments map[string]any) mcp.CallToolRequest {
+		return mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}}
+	}
+	keanu := neo4j.Node{ElementId: "4:db:1", Labels: []string{"Person"}, Props: map[string]any{"name": "Keanu Reeves", "born": int64(1964)}}
+
+	t.Run("searches a node index", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), isFulltextIndexLookup(), map[string]any{"index": "names"}).
+			Return(fulltextIndexRecords("NODE"), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), map[string]any{"index": "names", "query": "name:keanu~", "limit": 10}).
+			DoAndReturn(func(_ context.Context, query string, _ map[string]any) ([]*neo4j.Record, error) {
+				if !strings.Contains(query, "db.index.fulltext.queryNodes($index, $query, {limit: $limit}) YIELD node, score") {
+					t.Errorf("Expected a node search, got %s", query)
+				}
+				return []*neo4j.Record{{Keys: []string{"node", "score"}, Values: []any{keanu, 2.5}}}, nil
+			})
+
+		handler := search.FulltextSearchHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), request(map[string]any{"index": "names", "query": "name:keanu~"}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.Contains(text, "Keanu Reeves") || !strings.Contains(text, `"score": 2.5`) {
+			t.Errorf("Expected the node and its score, got %s", text)
+		}
+	})
+
+	t.Run("selects the returned properties of relationships", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), isFulltextIndexLookup(), gomock.Any()).
+			Return(fulltextIndexRecords("RELATIONSHIP"), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, query string, params map[string]any) ([]*neo4j.Record, error) {
+				if !strings.Contains(query, "db.index.fulltext.queryRelationships") ||
+					!strings.Contains(query, "RETURN elementId(relationship) AS elementId, relationship {.`summary`, .`odd``name`} AS properties, score") {
+					t.Errorf("Expected a relationship search returning the selected properties, got %s", query)
+				}
+				if params["limit"] != 3 {
+					t.Errorf("Expected the requested limit, got %v", params)
+				}
+				return nil, nil
+			})
+
+		handler := search.FulltextSearchHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), request(map[string]any{"index": "reviews", "query": "great", "limit": 3, "properties": []any{"summary", "odd`name"}}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+	})
+
+	t.Run("unknown index", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), isFulltextIndexLookup(), gomock.Any()).
+			Return(nil, nil)
+
+		handler := search.FulltextSearchHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), request(map[string]any{"index": "missing", "query": "keanu"}))
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "list-fulltext-indexes") {
+			t.Errorf("Expected error result pointing to list-fulltext-indexes, got: %v", result)
+		}
+	})
+
+	t.Run("invalid Lucene query", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), isFulltextIndexLookup(), gomock.Any()).
+			Return(fulltextIndexRecords("NODE"), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("Cannot parse 'name:(keanu': Encountered \"<EOF>\""))
+
+		handler := search.FulltextSearchHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), request(map[string]any{"index": "names", "query": "name:(keanu"}))
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "Cannot parse") {
+			t.Errorf("Expected the parse error of the query, got: %v", result)
+		}
+	})
+
+	invalid := []struct {
+		name      string
+		arguments map[string]any
+	}{
+		{name: "missing index", arguments: map[string]any{"query": "keanu"}},
+		{name: "missing query", arguments: map[string]any{"index": "names"}},
+		{name: "blank query", arguments: map[string]any{"index": "names", "query": "  "}},
+		{name: "negative limit", arguments: map[string]any{"index": "names", "query": "keanu", "limit": -1}},
+		{name: "limit over the maximum rows", arguments: map[string]any{"index": "names", "query": "keanu", "limit": 1000}},
+		{name: "database not allowed", arguments: map[string]any{"index": "names", "query": "keanu", "database": "system"}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := search.FulltextSearchHandler(&tools.ToolDependencies{DBService: db.NewMockService(ctrl), AnalyticsService: analyticsService, Config: cfg})
+			result, err := handler(context.Background(), request(tt.arguments))
+			if err != nil {
+				t.Fatalf("Expected no error from handler, got: %v", err)
+			}
+			if result == nil || !result.IsError {
+				t.Errorf("Expected error result, got: %v", result)
+			}
+		})
+	}
+}
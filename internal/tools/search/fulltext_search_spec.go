@@ -0,0 +1,28 @@
+package search
+
+import "github.com/mark3labs/mcp-go/mcp"
+
+type FulltextSearchInput struct {
+	Index      string   `json:"index" jsonschema:"description=Name of the fulltext index to search. Use list-fulltext-indexes to find the available indexes"`
+	Query      string   `json:"query" jsonschema:"description=Lucene query. For example keanu or title:matrix~ or title:matrix AND year:[1990 TO 2005]"`
+	Limit      int      `json:"limit,omitempty" jsonschema:"description=Maximum number of nodes or relationships returned. Defaults to 10"`
+	Properties []string `json:"properties,omitempty" jsonschema:"description=Properties returned for each result with its element id. Defaults to the whole node or relationship"`
+	Database   string   `json:"database,omitempty" jsonschema:"description=Name of the database to search. Defaults to the configured database. Use list-databases to find the available databases"`
+}
+
+func FulltextSearchSpec() mcp.Tool {
+	return mcp.NewTool("fulltext-search",
+		mcp.WithDescription(
+			"Find nodes or relationships by name or text with a fulltext index, instead of matching strings with CONTAINS in Cypher. "+
+				"The query uses the Lucene syntax: terms, phrases in double quotes, field:term to search a single property, "+
+				"wildcards (matri*), fuzzy terms (matrx~), ranges and AND, OR, NOT. "+
+				"Results are returned with their relevance score, most relevant first. Use list-fulltext-indexes to find the available indexes.",
+		),
+		mcp.WithInputSchema[FulltextSearchInput](),
+		mcp.WithTitleAnnotation("Fulltext Search"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
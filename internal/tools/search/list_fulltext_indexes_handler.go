@@ -0,0 +1,66 @@
+package search
+
+import (
+	"context"
+	"log"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+const listFulltextIndexesQuery = `
+SHOW FULLTEXT INDEXES YIELD name, state, entityType, labelsOrTypes, properties, options
+RETURN name, state, entityType, labelsOrTypes, properties,
+  options.indexConfig.` + "`fulltext.analyzer`" + ` AS analyzer
+ORDER BY name`
+
+// ListFulltextIndexesHandler returns a handler function for the list-fulltext-indexes tool
+func ListFulltextIndexesHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleListFulltextIndexes(ctx, request, deps.DBService, deps.AnalyticsService, deps.Config)
+	}
+}
+
+// handleListFulltextIndexes lists the fulltext indexes of the targeted database
+func handleListFulltextIndexes(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if asService == nil {
+		errMessage := "Analytics service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if dbService == nil {
+		errMessage := "Database service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	asService.EmitEvent(asService.NewToolsEvent("list-fulltext-indexes"))
+
+	var args ListFulltextIndexesInput
+	if err := request.BindArguments(&args); err != nil {
+		log.Printf("Error binding arguments: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ctx, err := tools.DatabaseContext(ctx, cfg, args.Database)
+	if err != nil {
+		log.Printf("Rejected database %q: %v", args.Database, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	records, err := dbService.ExecuteReadQuery(ctx, listFulltextIndexesQuery, nil)
+	if err != nil {
+		log.Printf("Failed to execute list-fulltext-indexes query: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	response, err := tools.FormatRecords(cfg, records)
+	if err != nil {
+		log.Printf("Failed to format list-fulltext-indexes results: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(response), nil
+}
@@ -0,0 +1,77 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/search"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListFulltextIndexesHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("list-fulltext-indexes").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Database: "neo4j"}
+
+	t.Run("lists the fulltext indexes", func(t *testing.T) {
+		keys := []string{"name", "state", "entityType", "labelsOrTypes", "properties", "analyzer"}
+		records := []*neo4j.Record{{Keys: keys, Values: []any{"names", "ONLINE", "NODE", []any{"Person", "Movie"}, []any{"name", "title"}, "standard-no-stop-words"}}}
+
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(targetsDatabase(""), gomock.Cond(func(query string) bool { return strings.Contains(query, "SHOW FULLTEXT INDEXES") }), gomock.Nil()).
+			Return(records, nil)
+
+		compact := &config.Config{Database: "neo4j", ResultFormat: config.ResultFormatCompactJSON}
+		handler := search.ListFulltextIndexesHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: compact})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, `{"columns":["name","state",`) || !strings.Contains(text, `["names","ONLINE"`) {
+			t.Errorf("Expected the indexes in the configured format, got %s", text)
+		}
+	})
+
+	t.Run("query failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return(nil, errors.New("connection refused"))
+
+		handler := search.ListFulltextIndexesHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for a query failure")
+		}
+	})
+
+	t.Run("nil analytics service", func(t *testing.T) {
+		handler := search.ListFulltextIndexesHandler(&tools.ToolDependencies{DBService: db.NewMockService(ctrl), Config: cfg})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for nil analytics service")
+		}
+	})
+}
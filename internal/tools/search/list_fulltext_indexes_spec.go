@@ -0,0 +1,23 @@
+package search
+
+import "github.com/mark3labs/mcp-go/mcp"
+
+type ListFulltextIndexesInput struct {
+	Database string `json:"database,omitempty" jsonschema:"description=Name of the database whose fulltext indexes are listed. Defaults to the configured database. Use list-databases to find the available databases"`
+}
+
+func ListFulltextIndexesSpec() mcp.Tool {
+	return mcp.NewTool("list-fulltext-indexes",
+		mcp.WithDescription(
+			"List the fulltext indexes of the Neo4j database, with their name, state, whether they index nodes or relationships, "+
+				"the labels or relationship types and the properties they index and their analyzer. "+
+				"Use it before fulltext-search to find the index to search.",
+		),
+		mcp.WithInputSchema[ListFulltextIndexesInput](),
+		mcp.WithTitleAnnotation("List Neo4j Fulltext Indexes"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,67 @@
+package search
+
+import (
+	"context"
+	"log"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+const listVectorIndexesQuery = `
+SHOW VECTOR INDEXES YIELD name, state, entityType, labelsOrTypes, properties, options
+RETURN name, state, entityType, labelsOrTypes, properties,
+  options.indexConfig.` + "`vector.dimensions`" + ` AS dimensions,
+  options.indexConfig.` + "`vector.similarity_function`" + ` AS similarityFunction
+ORDER BY name`
+
+// ListVectorIndexesHandler returns a handler function for the list-vector-indexes tool
+func ListVectorIndexesHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleListVectorIndexes(ctx, request, deps.DBService, deps.AnalyticsService, deps.Config)
+	}
+}
+
+// handleListVectorIndexes lists the vector indexes of the targeted database
+func handleListVectorIndexes(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if asService == nil {
+		errMessage := "Analytics service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if dbService == nil {
+		errMessage := "Database service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	asService.EmitEvent(asService.NewToolsEvent("list-vector-indexes"))
+
+	var args ListVectorIndexesInput
+	if err := request.BindArguments(&args); err != nil {
+		log.Printf("Error binding arguments: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ctx, err := tools.DatabaseContext(ctx, cfg, args.Database)
+	if err != nil {
+		log.Printf("Rejected database %q: %v", args.Database, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	records, err := dbService.ExecuteReadQuery(ctx, listVectorIndexesQuery, nil)
+	if err != nil {
+		log.Printf("Failed to execute list-vector-indexes query: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	response, err := tools.FormatRecords(cfg, records)
+	if err != nil {
+		log.Printf("Failed to format list-vector-indexes results: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(response), nil
+}
@@ -0,0 +1,96 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/search"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+// targetsDatabase matches contexts targeting the named database, none when name is empty
+func targetsDatabase(name string) gomock.Matcher {
+	return gomock.Cond(func(ctx context.Context) bool {
+		got, ok := database.DatabaseFromContext(ctx)
+		return got == name && ok == (name != "")
+	})
+}
+
+func TestListVectorIndexesHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("list-vector-indexes").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Database: "neo4j", AllowedDatabases: []string{"movies"}}
+
+	t.Run("lists the vector indexes", func(t *testing.T) {
+		keys := []string{"name", "state", "entityType", "labelsOrTypes", "properties", "dimensions", "similarityFunction"}
+		records := []*neo4j.Record{{Keys: keys, Values: []any{"moviePlots", "ONLINE", "NODE", []any{"Movie"}, []any{"embedding"}, int64(1536), "COSINE"}}}
+
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(targetsDatabase("movies"), gomock.Cond(func(query string) bool { return strings.Contains(query, "SHOW VECTOR INDEXES") }), gomock.Nil()).
+			Return(records, nil)
+
+		handler := search.ListVectorIndexesHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"database": "movies"}}})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, `"name": "moviePlots"`) {
+			t.Errorf("Expected the formatted indexes, got %s", text)
+		}
+	})
+
+	t.Run("database not allowed", func(t *testing.T) {
+		handler := search.ListVectorIndexesHandler(&tools.ToolDependencies{DBService: db.NewMockService(ctrl), AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"database": "system"}}})
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for a database not allowed")
+		}
+	})
+
+	t.Run("query failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return(nil, errors.New("connection refused"))
+
+		handler := search.ListVectorIndexesHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for a query failure")
+		}
+	})
+
+	t.Run("nil database service", func(t *testing.T) {
+		handler := search.ListVectorIndexesHandler(&tools.ToolDependencies{AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for nil database service")
+		}
+	})
+}
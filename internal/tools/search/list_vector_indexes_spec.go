@@ -0,0 +1,23 @@
+package search
+
+import "github.com/mark3labs/mcp-go/mcp"
+
+type ListVectorIndexesInput struct {
+	Database string `json:"database,omitempty" jsonschema:"description=Name of the database whose vector indexes are listed. Defaults to the configured database. Use list-databases to find the available databases"`
+}
+
+func ListVectorIndexesSpec() mcp.Tool {
+	return mcp.NewTool("list-vector-indexes",
+		mcp.WithDescription(
+			"List the vector indexes of the Neo4j database, with their name, state, whether they index nodes or relationships, "+
+				"the labels or relationship types and the property they index, their number of dimensions and similarity function. "+
+				"Use it before vector-search to find the index to search and the dimensions of the query vector.",
+		),
+		mcp.WithInputSchema[ListVectorIndexesInput](),
+		mcp.WithTitleAnnotation("List Neo4j Vector Indexes"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
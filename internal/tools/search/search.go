@@ -0,0 +1,96 @@
+// Package search implements the tools searching the vector and fulltext indexes of Neo4j.
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/format"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+const (
+	// defaultLimit is the number of results of a search that does not request any
+	defaultLimit = 10
+
+	// indexQuery looks up an index of the kind formatted in
+	indexQuery = `
+SHOW %s INDEXES YIELD name, entityType, properties
+WHERE name = $index
+RETURN entityType, properties`
+
+	// relationshipIndex is the entity type of the indexes of relationships
+	relationshipIndex = "RELATIONSHIP"
+)
+
+// lookupIndex returns the entity type and the properties of the named index of the given kind, vector or fulltext
+func lookupIndex(ctx context.Context, dbService database.Service, kind, name string) (string, []string, error) {
+	query := fmt.Sprintf(indexQuery, strings.ToUpper(kind))
+	records, err := dbService.ExecuteReadQuery(ctx, query, map[string]any{"index": name})
+	if err != nil {
+		return "", nil, err
+	}
+	if len(records) == 0 {
+		return "", nil, fmt.Errorf("%s index %q does not exist, use list-%s-indexes to find the available indexes", kind, name, kind)
+	}
+
+	entityType, _, err := neo4j.GetRecordValue[string](records[0], "entityType")
+	if err != nil {
+		return "", nil, err
+	}
+	values, _, err := neo4j.GetRecordValue[[]any](records[0], "properties")
+	if err != nil {
+		return "", nil, err
+	}
+	properties := make([]string, 0, len(values))
+	for _, value := range values {
+		if property, ok := value.(string); ok {
+			properties = append(properties, property)
+		}
+	}
+	return entityType, properties, nil
+}
+
+// resultLimit returns the number of results requested by the named argument of a tool call, defaultLimit when none is requested.
+// It must not exceed the maximum number of rows of the configuration.
+func resultLimit(cfg *config.Config, name string, requested int) (int, error) {
+	if requested < 0 {
+		return 0, fmt.Errorf("%s must not be negative but was %d", name, requested)
+	}
+	if requested == 0 {
+		requested = defaultLimit
+	}
+	if cfg != nil && cfg.MaxRows > 0 && requested > cfg.MaxRows {
+		return 0, fmt.Errorf("%s of %d exceeds the maximum of %d rows", name, requested, cfg.MaxRows)
+	}
+	return requested, nil
+}
+
+// vectorOptions returns the vectors left out of the search results by the configuration
+func vectorOptions(cfg *config.Config) database.VectorOptions {
+	if cfg == nil {
+		return database.VectorOptions{}
+	}
+	return database.VectorOptions{MinDimensions: cfg.VectorMinDimensions, Properties: append([]string(nil), cfg.VectorProperties...)}
+}
+
+// formatRecords formats the search results in the configured result format, keys being their columns
+func formatRecords(cfg *config.Config, keys []string, records []*neo4j.Record) (string, error) {
+	name := ""
+	if cfg != nil {
+		name = cfg.ResultFormat
+	}
+	formatter, err := format.Get(name)
+	if err != nil {
+		return "", err
+	}
+	return formatter.Format(keys, records)
+}
+
+// quoteIdentifier returns name quoted with backticks, so that any property name is safe to use in a query
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
@@ -0,0 +1,190 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/embedding"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+)
+
+// filterCandidatesFactor is the number of candidates taken from the index per result requested, when the results are filtered
+const filterCandidatesFactor = 10
+
+// VectorSearchHandler returns a handler function for the vector-search tool
+func VectorSearchHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleVectorSearch(ctx, request, deps.DBService, deps.AnalyticsService, deps.Config, deps.Embedder)
+	}
+}
+
+// handleVectorSearch queries a vector index for the nodes or relationships most similar to the requested vector or text
+func handleVectorSearch(ctx context.Context, request mcp.CallToolRequest, dbService database.Service, asService analytics.Service, cfg *config.Config, embedder embedding.Provider) (*mcp.CallToolResult, error) {
+	if asService == nil {
+		errMessage := "Analytics service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if dbService == nil {
+		errMessage := "Database service is not initialized"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	asService.EmitEvent(asService.NewToolsEvent("vector-search"))
+
+	var args VectorSearchInput
+	if err := cypher.BindArguments(request, &args); err != nil {
+		log.Printf("Error binding arguments: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	// Filter values are passed to the query as is, integers must not reach it as floats
+	for i := range args.Filters {
+		args.Filters[i].Value = cypher.ConvertNumbers(args.Filters[i].Value)
+	}
+
+	if args.Index == "" {
+		errMessage := "Index parameter is required and cannot be empty"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if (len(args.Vector) == 0) == (args.Text == "") {
+		errMessage := "Exactly one of the vector and text parameters is required"
+		log.Printf("%s", errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	conditions, filterParams, err := filterConditions(args.Filters, args.MinScore)
+	if err != nil {
+		log.Printf("Rejected vector-search filters %+v: %v", args.Filters, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	topK, err := resultLimit(cfg, "top_k", args.TopK)
+	if err != nil {
+		log.Printf("Rejected top_k %d: %v", args.TopK, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ctx, err = tools.DatabaseContext(ctx, cfg, args.Database)
+	if err != nil {
+		log.Printf("Rejected database %q: %v", args.Database, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	entityType, properties, err := lookupIndex(ctx, dbService, "vector", args.Index)
+	if err != nil {
+		log.Printf("Failed to look up vector index %q: %v", args.Index, err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	vector := args.Vector
+	if args.Text != "" {
+		if vector, err = embed(ctx, embedder, args.Text); err != nil {
+			log.Printf("Failed to embed vector-search text: %v", err)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
+	query, keys := vectorSearchQuery(entityType, conditions)
+	candidates := topK
+	if len(conditions) > 0 {
+		candidates = topK * filterCandidatesFactor
+	}
+	params := map[string]any{"index": args.Index, "candidates": candidates, "vector": vector, "topK": topK}
+	for name, value := range filterParams {
+		params[name] = value
+	}
+
+	records, err := dbService.ExecuteReadQuery(ctx, query, params)
+	if err != nil {
+		log.Printf("Failed to execute vector-search query: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// The indexed property holds the vectors compared, never worth returning
+	vectors := vectorOptions(cfg)
+	vectors.Properties = append(vectors.Properties, properties...)
+	response, err := formatRecords(cfg, keys, database.ElideVectors(records, vectors))
+	if err != nil {
+		log.Printf("Failed to format vector-search results: %v", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(response), nil
+}
+
+// embed returns the vector of text computed by embedder
+func embed(ctx context.Context, embedder embedding.Provider, text string) ([]float64, error) {
+	if embedder == nil {
+		return nil, fmt.Errorf("searching for a text requires an embedding provider, set NEO4J_MCP_EMBEDDING_PROVIDER or pass a vector instead")
+	}
+	vector, err := embedder.Embed(ctx, text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute the vector of the text: %w", err)
+	}
+	return vector, nil
+}
+
+// vectorSearchQuery returns the query searching an index of entityType for the closest entities satisfying conditions, and its columns
+func vectorSearchQuery(entityType string, conditions []string) (string, []string) {
+	procedure, variable := "db.index.vector.queryNodes", "node"
+	if entityType == relationshipIndex {
+		procedure, variable = "db.index.vector.queryRelationships", "relationship"
+	}
+
+	// The conditions apply to the entity variable whatever the entity type
+	query := fmt.Sprintf("CALL %s($index, $candidates, $vector) YIELD %s AS entity, score\n", procedure, variable)
+	if len(conditions) > 0 {
+		query += "WHERE " + strings.Join(conditions, " AND ") + "\n"
+	}
+	return query + fmt.Sprintf("RETURN entity AS %s, score\nORDER BY score DESC\nLIMIT $topK", variable), []string{variable, "score"}
+}
+
+// filterOperators are the operators of the filters, with whether they take a value
+var filterOperators = map[string]bool{
+	"=": true, "<>": true, "<": true, "<=": true, ">": true, ">=": true,
+	"IN": true, "STARTS WITH": true, "ENDS WITH": true, "CONTAINS": true,
+	"IS NULL": false, "IS NOT NULL": false,
+}
+
+// filterConditions returns the Cypher conditions of filters and minScore over the entity variable, with the parameters holding their values.
+// Property names are quoted and values passed as parameters, so filters cannot change the query beyond their condition.
+func filterConditions(filters []VectorFilter, minScore float64) ([]string, map[string]any, error) {
+	conditions := make([]string, 0, len(filters)+1)
+	params := make(map[string]any, len(filters)+1)
+	for i, filter := range filters {
+		if filter.Property == "" {
+			return nil, nil, fmt.Errorf("filter %d has no property", i)
+		}
+		operator := strings.ToUpper(strings.Join(strings.Fields(filter.Operator), " "))
+		takesValue, ok := filterOperators[operator]
+		if !ok {
+			return nil, nil, fmt.Errorf("filter %d has unknown operator %q, must be one of =, <>, <, <=, >, >=, IN, STARTS WITH, ENDS WITH, CONTAINS, IS NULL or IS NOT NULL", i, filter.Operator)
+		}
+		if !takesValue {
+			conditions = append(conditions, fmt.Sprintf("entity.%s %s", quoteIdentifier(filter.Property), operator))
+			continue
+		}
+		if filter.Value == nil {
+			return nil, nil, fmt.Errorf("filter %d has no value for operator %s", i, operator)
+		}
+		name := fmt.Sprintf("filter%d", i)
+		conditions = append(conditions, fmt.Sprintf("entity.%s %s $%s", quoteIdentifier(filter.Property), operator, name))
+		params[name] = filter.Value
+	}
+	if minScore < 0 {
+		return nil, nil, fmt.Errorf("min_score must not be negative but was %v", minScore)
+	}
+	if minScore > 0 {
+		conditions = append(conditions, "score >= $minScore")
+		params["minScore"] = minScore
+	}
+	return conditions, params, nil
+}
@@ -0,0 +1,242 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/search"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+// fixedEmbedder returns the same vector for every text
+type fixedEmbedder struct {
+	vector []float64
+	err    error
+}
+
+func (e fixedEmbedder) Embed(context.Context, string) ([]float64, error) {
+	return e.vector, e.err
+}
+
+// isVectorIndexLookup matches the query looking up the vector index searched
+func isVectorIndexLookup() gomock.Matcher {
+	return gomock.Cond(func(query string) bool { return strings.Contains(query, "SHOW VECTOR INDEXES") })
+}
+
+// indexRecords returns the lookup result of a vector index of entityType indexing the embedding property
+func indexRecords(entityType string) []*neo4j.Record {
+	return []*neo4j.Record{{Keys: []string{"entityType", "properties"}, Values: []any{entityType, []any{"embedding"}}}}
+}
+
+func TestVectorSearchHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	analyticsService := analytics.NewMockService(ctrl)
+	analyticsService.EXPECT().NewToolsEvent("vector-search").AnyTimes()
+	analyticsService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Database: "neo4j", MaxRows: 100}
+	request := func(arguments map[string]any) mcp.CallToolRequest {
+		return mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}}
+	}
+	movie := neo4j.Node{ElementId: "4:db:1", Labels: []string{"Movie"}, Props: map[string]any{"title": "The Matrix", "embedding": []any{0.1, 0.2, 0.3}}}
+	results := []*neo4j.Record{{Keys: []string{"node", "score"}, Values: []any{movie, 0.93}}}
+
+	t.Run("searches a node index with a vector", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), isVectorIndexLookup(), map[string]any{"index": "moviePlots"}).
+			Return(indexRecords("NODE"), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), map[string]any{"index": "moviePlots", "candidates": 3, "vector": []float64{0.1, 0.2, 0.3}, "topK": 3}).
+			DoAndReturn(func(_ context.Context, query string, _ map[string]any) ([]*neo4j.Record, error) {
+				if !strings.Contains(query, "db.index.vector.queryNodes($index, $candidates, $vector) YIELD node AS entity") || strings.Contains(query, "WHERE") {
+					t.Errorf("Expected an unfiltered node search, got %s", query)
+				}
+				return results, nil
+			})
+
+		handler := search.VectorSearchHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), request(map[string]any{"index": "moviePlots", "vector": []any{0.1, 0.2, 0.3}, "top_k": 3}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.Contains(text, "The Matrix") || !strings.Contains(text, `"score": 0.93`) {
+			t.Errorf("Expected the node and its score, got %s", text)
+		}
+		if !strings.Contains(text, `"dims": 3`) || strings.Contains(text, "0.2") {
+			t.Errorf("Expected the indexed property to be returned as a vector placeholder, got %s", text)
+		}
+	})
+
+	t.Run("searches a relationship index with a text", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), isVectorIndexLookup(), gomock.Any()).
+			Return(indexRecords("RELATIONSHIP"), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, query string, params map[string]any) ([]*neo4j.Record, error) {
+				if !strings.Contains(query, "db.index.vector.queryRelationships") || !strings.Contains(query, "RETURN entity AS relationship, score") {
+					t.Errorf("Expected a relationship search, got %s", query)
+				}
+				if !reflect.DeepEqual(params["vector"], []float64{1, 0}) || params["topK"] != 10 {
+					t.Errorf("Expected the embedded text and the default top_k, got %v", params)
+				}
+				return nil, nil
+			})
+
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, Embedder: fixedEmbedder{vector: []float64{1, 0}}}
+		result, err := search.VectorSearchHandler(deps)(context.Background(), request(map[string]any{"index": "reviews", "text": "great acting"}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+	})
+
+	t.Run("filters the candidates", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), isVectorIndexLookup(), gomock.Any()).
+			Return(indexRecords("NODE"), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, query string, params map[string]any) ([]*neo4j.Record, error) {
+				if !strings.Contains(query, "YIELD node AS entity, score\nWHERE entity.`year` > $filter0 AND entity.`odd``name` IS NOT NULL AND score >= $minScore\n") {
+					t.Errorf("Expected the filters in the query, got %s", query)
+				}
+				if params["candidates"] != 50 || params["topK"] != 5 || params["filter0"] != int64(2000) || params["minScore"] != 0.8 {
+					t.Errorf("Expected more candidates than results and the filter values, got %v", params)
+				}
+				return results, nil
+			})
+
+		handler := search.VectorSearchHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), request(map[string]any{
+			"index":  "moviePlots",
+			"vector": []any{0.1},
+			"top_k":  5,
+			"filters": []any{
+				map[string]any{"property": "year", "operator": ">", "value": 2000},
+				map[string]any{"property": "odd`name", "operator": "is  not null"},
+			},
+			"min_score": 0.8,
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+	})
+
+	t.Run("unknown index", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), isVectorIndexLookup(), gomock.Any()).
+			Return(nil, nil)
+
+		handler := search.VectorSearchHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), request(map[string]any{"index": "missing", "vector": []any{0.1}}))
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "list-vector-indexes") {
+			t.Errorf("Expected error result pointing to list-vector-indexes, got: %v", result)
+		}
+	})
+
+	t.Run("embedding failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), isVectorIndexLookup(), gomock.Any()).
+			Return(indexRecords("NODE"), nil)
+
+		deps := &tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg, Embedder: fixedEmbedder{err: errors.New("model not found")}}
+		result, err := search.VectorSearchHandler(deps)(context.Background(), request(map[string]any{"index": "moviePlots", "text": "heist"}))
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for an embedding failure")
+		}
+	})
+
+	t.Run("query failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), isVectorIndexLookup(), gomock.Any()).
+			Return(indexRecords("NODE"), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("vector dimensions do not match"))
+
+		handler := search.VectorSearchHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), request(map[string]any{"index": "moviePlots", "vector": []any{0.1}}))
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for a query failure")
+		}
+	})
+
+	invalid := []struct {
+		name      string
+		arguments map[string]any
+	}{
+		{name: "missing index", arguments: map[string]any{"vector": []any{0.1}}},
+		{name: "neither vector nor text", arguments: map[string]any{"index": "moviePlots"}},
+		{name: "both vector and text", arguments: map[string]any{"index": "moviePlots", "vector": []any{0.1}, "text": "heist"}},
+		{name: "negative top_k", arguments: map[string]any{"index": "moviePlots", "vector": []any{0.1}, "top_k": -1}},
+		{name: "top_k over the maximum rows", arguments: map[string]any{"index": "moviePlots", "vector": []any{0.1}, "top_k": 1000}},
+		{name: "database not allowed", arguments: map[string]any{"index": "moviePlots", "vector": []any{0.1}, "database": "system"}},
+		{name: "unknown filter operator", arguments: map[string]any{"index": "moviePlots", "vector": []any{0.1}, "filters": []any{map[string]any{"property": "year", "operator": "> 0) RETURN 1 //", "value": 1}}}},
+		{name: "filter without property", arguments: map[string]any{"index": "moviePlots", "vector": []any{0.1}, "filters": []any{map[string]any{"operator": "=", "value": 1}}}},
+		{name: "filter without value", arguments: map[string]any{"index": "moviePlots", "vector": []any{0.1}, "filters": []any{map[string]any{"property": "year", "operator": "="}}}},
+		{name: "negative min_score", arguments: map[string]any{"index": "moviePlots", "vector": []any{0.1}, "min_score": -1}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := search.VectorSearchHandler(&tools.ToolDependencies{DBService: db.NewMockService(ctrl), AnalyticsService: analyticsService, Config: cfg})
+			result, err := handler(context.Background(), request(tt.arguments))
+			if err != nil {
+				t.Fatalf("Expected no error from handler, got: %v", err)
+			}
+			if result == nil || !result.IsError {
+				t.Errorf("Expected error result, got: %v", result)
+			}
+		})
+	}
+
+	t.Run("text without embedding provider", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), isVectorIndexLookup(), gomock.Any()).
+			Return(indexRecords("NODE"), nil)
+
+		handler := search.VectorSearchHandler(&tools.ToolDependencies{DBService: mockDB, AnalyticsService: analyticsService, Config: cfg})
+		result, err := handler(context.Background(), request(map[string]any{"index": "moviePlots", "text": "heist"}))
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "NEO4J_MCP_EMBEDDING_PROVIDER") {
+			t.Errorf("Expected error result naming the embedding provider setting, got: %v", result)
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package search
+
+import "github.com/mark3labs/mcp-go/mcp"
+
+type VectorSearchInput struct {
+	Index    string         `json:"index" jsonschema:"description=Name of the vector index to search. Use list-vector-indexes to find the available indexes"`
+	Vector   []float64      `json:"vector,omitempty" jsonschema:"description=Query vector with the dimensions of the index. Either vector or text is required"`
+	Text     string         `json:"text,omitempty" jsonschema:"description=Text to search for. Its vector is computed by the embedding provider of the server. Either vector or text is required"`
+	TopK     int            `json:"top_k,omitempty" jsonschema:"description=Number of most similar nodes or relationships returned. Defaults to 10"`
+	Filters  []VectorFilter `json:"filters,omitempty" jsonschema:"description=Conditions on the properties of the nodes or relationships that every result must satisfy"`
+	MinScore float64        `json:"min_score,omitempty" jsonschema:"description=Minimum similarity score of the results"`
+	Database string         `json:"database,omitempty" jsonschema:"description=Name of the database to search. Defaults to the configured database. Use list-databases to find the available databases"`
+}
+
+// VectorFilter compares a property of the search results with a value, passed to the query as a parameter
+type VectorFilter struct {
+	Property string `json:"property" jsonschema:"description=Name of the property compared"`
+	Operator string `json:"operator" jsonschema:"description=One of = <> < <= > >= IN STARTS WITH ENDS WITH CONTAINS IS NULL IS NOT NULL"`
+	Value    any    `json:"value,omitempty" jsonschema:"description=Value the property is compared with. A list for IN and none for IS NULL and IS NOT NULL"`
+}
+
+func VectorSearchSpec() mcp.Tool {
+	return mcp.NewTool("vector-search",
+		mcp.WithDescription(
+			"Semantic search: return the nodes or relationships of a vector index most similar to a query vector, with their similarity score, most similar first. "+
+				"Pass either a vector with the dimensions of the index or a text, which needs an embedding provider configured on the server. "+
+				"The optional filters on properties and min_score are applied to the closest candidates of the index, "+
+				"so selective filters may return fewer than top_k results. Use list-vector-indexes to find the available indexes.",
+		),
+		mcp.WithInputSchema[VectorSearchInput](),
+		mcp.WithTitleAnnotation("Vector Search"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,15 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// SessionID returns the ID of the MCP client session a tool call belongs to, empty when it is unknown
+func SessionID(ctx context.Context) string {
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		return session.SessionID()
+	}
+	return ""
+}
@@ -2,11 +2,19 @@ package tools
 
 import (
 	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/cursor"
 	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/embedding"
+	"github.com/neo4j/mcp/internal/schemacache"
 )
 
 // ToolDependencies contains all dependencies needed by tools
 type ToolDependencies struct {
 	DBService        database.Service
 	AnalyticsService analytics.Service
+	Config           *config.Config
+	Cursors          *cursor.Store      // nil when pagination is disabled
+	SchemaCache      *schemacache.Cache // nil when the schema cache is disabled
+	Embedder         embedding.Provider // nil when no embedding provider is configured
 }
@@ -0,0 +1,45 @@
+//go:build integration
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/mcp/test/integration/helpers"
+)
+
+func TestExplainCypher(t *testing.T) {
+	t.Parallel()
+	t.Run("explain-cypher should return the plan without running the query", func(t *testing.T) {
+		tc := helpers.NewTestContext(t, dbs.GetDriver())
+
+		personLabel := tc.GetUniqueLabel("Person")
+
+		explain := cypher.ExplainCypherHandler(tc.Deps)
+		res := tc.CallTool(explain, map[string]any{
+			"query":  "CREATE (p:" + personLabel + " {name: $name}) RETURN p",
+			"params": map[string]any{"name": "Alice"},
+		})
+
+		result, ok := res.StructuredContent.(*database.ExplainResult)
+		if !ok {
+			t.Fatalf("expected structured content of type *database.ExplainResult, got %T", res.StructuredContent)
+		}
+		if result.StatementType != "READ_WRITE" {
+			t.Errorf("expected statement type READ_WRITE, got %s", result.StatementType)
+		}
+		if result.Plan == nil || result.Plan.Operator == "" {
+			t.Fatalf("expected a plan, got %+v", result.Plan)
+		}
+
+		read := cypher.ReadCypherHandler(tc.Deps)
+		res = tc.CallTool(read, map[string]any{"query": "MATCH (p:" + personLabel + ") RETURN count(p) AS count"})
+		var records []map[string]any
+		tc.ParseJSONResponse(res, &records)
+		if len(records) != 1 || records[0]["count"] != float64(0) {
+			t.Errorf("expected the explained query not to run, got %v", records)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/tools/search"
+	"github.com/neo4j/mcp/test/integration/helpers"
+)
+
+func TestFulltextSearch(t *testing.T) {
+	t.Parallel()
+
+	tc := helpers.NewTestContext(t, dbs.GetDriver())
+	ctx := context.Background()
+
+	personLabel := tc.GetUniqueLabel("Person")
+	for _, name := range []string{"Keanu Reeves", "Carrie-Anne Moss", "Laurence Fishburne"} {
+		if _, err := tc.Service.ExecuteWriteQuery(ctx, fmt.Sprintf("CREATE (:%s {name: $name, born: 1964})", personLabel), map[string]any{"name": name}); err != nil {
+			t.Fatalf("failed to seed data: %v", err)
+		}
+	}
+
+	index := "fulltext_" + tc.TestID
+	if _, err := tc.Service.ExecuteWriteQuery(ctx, fmt.Sprintf("CREATE FULLTEXT INDEX %s FOR (p:%s) ON EACH [p.name]", index, personLabel), nil); err != nil {
+		t.Fatalf("failed to create fulltext index: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = tc.Service.ExecuteWriteQuery(context.Background(), "DROP INDEX "+index+" IF EXISTS", nil)
+	})
+	if _, err := tc.Service.ExecuteWriteQuery(ctx, "CALL db.awaitIndexes(60)", nil); err != nil {
+		t.Fatalf("failed to wait for the fulltext index: %v", err)
+	}
+
+	t.Run("list-fulltext-indexes should list the index", func(t *testing.T) {
+		res := tc.CallTool(search.ListFulltextIndexesHandler(tc.Deps), nil)
+
+		var indexes []map[string]any
+		tc.ParseJSONResponse(res, &indexes)
+		for _, i := range indexes {
+			if i["name"] == index {
+				if i["entityType"] != "NODE" {
+					t.Errorf("expected a node index, got %v", i)
+				}
+				return
+			}
+		}
+		t.Fatalf("expected %s among the fulltext indexes, got %v", index, indexes)
+	})
+
+	t.Run("fulltext-search should find nodes with a fuzzy query", func(t *testing.T) {
+		res := tc.CallTool(search.FulltextSearchHandler(tc.Deps), map[string]any{
+			"index": index,
+			"query": "name:keenu~",
+		})
+
+		var records []map[string]any
+		tc.ParseJSONResponse(res, &records)
+		if len(records) != 1 {
+			t.Fatalf("expected 1 record, got %d", len(records))
+		}
+		tc.AssertNodeProperties(records[0]["node"].(map[string]any), map[string]any{"name": "Keanu Reeves"})
+	})
+
+	t.Run("fulltext-search should return the selected properties", func(t *testing.T) {
+		res := tc.CallTool(search.FulltextSearchHandler(tc.Deps), map[string]any{
+			"index":      index,
+			"query":      "moss OR fishburne",
+			"limit":      1,
+			"properties": []any{"name"},
+		})
+
+		var records []map[string]any
+		tc.ParseJSONResponse(res, &records)
+		if len(records) != 1 {
+			t.Fatalf("expected 1 record, got %d", len(records))
+		}
+		properties := records[0]["properties"].(map[string]any)
+		if _, ok := properties["born"]; ok || properties["name"] == nil {
+			t.Errorf("expected only the name property, got %v", properties)
+		}
+		if records[0]["elementId"] == nil {
+			t.Errorf("expected the element id of the node, got %v", records[0])
+		}
+	})
+}
@@ -6,38 +6,11 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/neo4j/mcp/internal/schema"
 	"github.com/neo4j/mcp/internal/tools/cypher"
 	"github.com/neo4j/mcp/test/integration/helpers"
 )
 
-type SchemaPropertyType struct {
-	Array     bool   `json:"array"`
-	Existence bool   `json:"existence"`
-	Indexed   bool   `json:"indexed"`
-	Type      string `json:"type"`
-}
-
-type RelationshipType struct {
-	Count      int                           `json:"count"`
-	Direction  string                        `json:"direction"`
-	Labels     []string                      `json:"labels"`
-	Properties map[string]SchemaPropertyType `json:"properties"`
-}
-
-// SchemaValue represents the guaranteed structure inside the "value" field.
-type SchemaValue struct {
-	Properties    map[string]SchemaPropertyType `json:"properties"`
-	Relationships map[string]RelationshipType   `json:"relationships"`
-	Type          string                        `json:"type"`
-}
-
-// SchemaEntry represents one element of the returned by get-schema :
-// { "key": "...", "value": { ... } }
-type SchemaEntry struct {
-	Key   string      `json:"key"`
-	Value SchemaValue `json:"value"`
-}
-
 func TestGetSchema(t *testing.T) {
 	t.Parallel()
 	tc := helpers.NewTestContext(t, dbs.GetDriver())
@@ -55,48 +28,22 @@ func TestGetSchema(t *testing.T) {
 	getSchema := cypher.GetSchemaHandler(tc.Deps)
 	res := tc.CallTool(getSchema, nil)
 
-	var schemaEntries []SchemaEntry
-	tc.ParseJSONResponse(res, &schemaEntries)
-
-	if len(schemaEntries) == 0 {
-		t.Fatal("expected schema to contain at least one entry")
+	graphSchema, ok := res.StructuredContent.(*schema.Schema)
+	if !ok {
+		t.Fatalf("expected structured content of type *schema.Schema, got %T", res.StructuredContent)
 	}
-	assertSchemaHasLabel(t, schemaEntries, personLabel.String())
-	assertSchemaHasLabel(t, schemaEntries, companyLabel.String())
-
-	personEntry := getSchemaEntryByTypeOrLabel(schemaEntries, personLabel.String())
-	personProperties := map[string]SchemaPropertyType{
-		"name": {
-			Indexed:   false,
-			Array:     false,
-			Existence: false,
-			Type:      "STRING",
-		},
-		"age": {
-			Indexed:   false,
-			Array:     false,
-			Existence: false,
-			Type:      "INTEGER",
-		},
+	if len(graphSchema.Labels) == 0 {
+		t.Fatal("expected schema to contain at least one label")
 	}
-	assertSchemaEntryHasProperties(t, personEntry.Value.Properties, personProperties)
 
-	companyEntry := getSchemaEntryByTypeOrLabel(schemaEntries, companyLabel.String())
-	companyProperties := map[string]SchemaPropertyType{
-		"name": {
-			Indexed:   false,
-			Array:     false,
-			Existence: false,
-			Type:      "STRING",
-		},
-		"founded": {
-			Indexed:   false,
-			Array:     false,
-			Existence: false,
-			Type:      "INTEGER",
-		},
-	}
-	assertSchemaEntryHasProperties(t, companyEntry.Value.Properties, companyProperties)
+	assertLabelHasProperties(t, graphSchema, personLabel.String(), []schema.Property{
+		{Name: "age", Type: "INTEGER"},
+		{Name: "name", Type: "STRING"},
+	})
+	assertLabelHasProperties(t, graphSchema, companyLabel.String(), []schema.Property{
+		{Name: "founded", Type: "INTEGER"},
+		{Name: "name", Type: "STRING"},
+	})
 
 	// TODO keep extending the coverage of the schema tests:
 	// - test different types such as float, duration etc ...
@@ -104,44 +51,24 @@ func TestGetSchema(t *testing.T) {
 	// - test Relationship
 }
 
-// assertSchemaHasLabel checks if the schema contains a node type with expected label
-func assertSchemaHasLabel(t *testing.T, schemaEntries []SchemaEntry, label string) {
-	foundLabel := slices.ContainsFunc(schemaEntries, func(schemaEntry SchemaEntry) bool {
-		return schemaEntry.Key == label
+// assertLabelHasProperties checks that the schema contains the label with the expected properties
+func assertLabelHasProperties(t *testing.T, graphSchema *schema.Schema, label string, expected []schema.Property) {
+	t.Helper()
+	idx := slices.IndexFunc(graphSchema.Labels, func(l schema.Label) bool {
+		return l.Name == label
 	})
-
-	if !foundLabel {
+	if idx < 0 {
 		t.Fatalf("label %s was not found in the schema", label)
 	}
-}
-
-func getSchemaEntryByTypeOrLabel(schemaEntries []SchemaEntry, labelOrType string) SchemaEntry {
-	idx := slices.IndexFunc(schemaEntries, func(schemaEntry SchemaEntry) bool {
-		return schemaEntry.Key == labelOrType
-	})
-
-	return schemaEntries[idx]
-}
-
-func assertSchemaEntryHasProperties(t *testing.T, entryProperties map[string]SchemaPropertyType, expectedProperties map[string]SchemaPropertyType) {
-	for name, expected := range expectedProperties {
-		got, ok := entryProperties[name]
-		if !ok {
-			t.Fatalf("property %s expected for schema properties but not found, found properties: %v", name, entryProperties)
-		}
 
-		if got.Array != expected.Array {
-			t.Fatalf("property %s not found: Array mismatch (expected=%t got=%t)", name, expected.Array, got.Array)
+	properties := graphSchema.Labels[idx].Properties
+	for _, want := range expected {
+		i := slices.IndexFunc(properties, func(p schema.Property) bool { return p.Name == want.Name })
+		if i < 0 {
+			t.Fatalf("property %s expected for label %s but not found, found properties: %v", want.Name, label, properties)
 		}
-		if got.Existence != expected.Existence {
-			t.Fatalf("property %s not found: Existence mismatch (expected=%t got=%t)", name, expected.Existence, got.Existence)
+		if properties[i] != want {
+			t.Fatalf("property %s of label %s: expected %+v, got %+v", want.Name, label, want, properties[i])
 		}
-		if got.Indexed != expected.Indexed {
-			t.Fatalf("property %s not found: Indexed mismatch (expected=%t got=%t)", name, expected.Indexed, got.Indexed)
-		}
-		if got.Type != expected.Type {
-			t.Fatalf("property %s not found: Type mismatch (expected=%s got=%s)", name, expected.Type, got.Type)
-		}
-
 	}
 }
@@ -269,9 +269,9 @@ func (tc *TestContext) VerifyNodeInDB(label UniqueLabel, props map[string]any) *
 func (tc *TestContext) AssertNodeProperties(node map[string]any, expectedProps map[string]any) {
 	tc.t.Helper()
 
-	props, ok := node["Props"].(map[string]any)
+	props, ok := node["properties"].(map[string]any)
 	if !ok {
-		tc.t.Fatalf("expected 'Props' to be a map, got %T: %+v", node["Props"], node)
+		tc.t.Fatalf("expected 'properties' to be a map, got %T: %+v", node["properties"], node)
 	}
 
 	for key, expectedVal := range expectedProps {
@@ -292,9 +292,9 @@ func (tc *TestContext) AssertNodeProperties(node map[string]any, expectedProps m
 func (tc *TestContext) AssertNodeHasLabel(node map[string]any, expectedLabel UniqueLabel) {
 	tc.t.Helper()
 
-	labels, ok := node["Labels"].([]any)
+	labels, ok := node["labels"].([]any)
 	if !ok {
-		tc.t.Fatalf("expected 'Labels' to be a slice, got %T", node["Labels"])
+		tc.t.Fatalf("expected 'labels' to be a slice, got %T", node["labels"])
 	}
 
 	for _, label := range labels {
@@ -0,0 +1,54 @@
+//go:build integration
+
+package integration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/mcp/test/integration/helpers"
+)
+
+func TestProfileCypher(t *testing.T) {
+	t.Parallel()
+	t.Run("profile-cypher should return the profiled plan", func(t *testing.T) {
+		tc := helpers.NewTestContext(t, dbs.GetDriver())
+
+		personLabel, err := tc.SeedNode("Person", map[string]any{"name": "Alice"})
+		if err != nil {
+			t.Fatalf("failed to seed data: %v", err)
+		}
+
+		profile := cypher.ProfileCypherHandler(tc.Deps)
+		res := tc.CallTool(profile, map[string]any{
+			"query":  "MATCH (p:" + personLabel + " {name: $name}) RETURN p",
+			"params": map[string]any{"name": "Alice"},
+		})
+
+		result, ok := res.StructuredContent.(*database.ProfileResult)
+		if !ok {
+			t.Fatalf("expected structured content of type *database.ProfileResult, got %T", res.StructuredContent)
+		}
+		if result.Rows != 1 {
+			t.Errorf("expected 1 row, got %d", result.Rows)
+		}
+		if result.Plan == nil || result.DbHits == 0 {
+			t.Errorf("expected a plan with db hits, got %+v", result)
+		}
+	})
+
+	t.Run("profile-cypher should reject write queries", func(t *testing.T) {
+		tc := helpers.NewTestContext(t, dbs.GetDriver())
+
+		personLabel := tc.GetUniqueLabel("Person")
+
+		profile := cypher.ProfileCypherHandler(tc.Deps)
+		textError := tc.GetToolError(profile, map[string]any{"query": "CREATE (p:" + personLabel + ") RETURN p"})
+
+		if !strings.Contains(textError, "profile-cypher can only profile read-only Cypher statements.") {
+			t.Fatalf("profile-cypher did not reject CREATE query: %s", textError)
+		}
+	})
+}
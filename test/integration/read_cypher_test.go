@@ -58,4 +58,22 @@ func TestReadCypher(t *testing.T) {
 		}
 	})
 
+	t.Run("read-cypher should return records in the requested format", func(t *testing.T) {
+		tc := helpers.NewTestContext(t, dbs.GetDriver())
+
+		personLabel, err := tc.SeedNode("Person", map[string]any{"name": "Alice"})
+		if err != nil {
+			t.Fatalf("failed to seed data: %v", err)
+		}
+
+		read := cypher.ReadCypherHandler(tc.Deps)
+		res := tc.CallTool(read, map[string]any{
+			"query":  "MATCH (p:" + personLabel + ") RETURN p.name AS name",
+			"format": "csv",
+		})
+
+		if got := tc.ParseTextResponse(res); got != "name\nAlice\n" {
+			t.Fatalf("expected CSV records, got %q", got)
+		}
+	})
 }
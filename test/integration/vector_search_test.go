@@ -0,0 +1,90 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/tools/search"
+	"github.com/neo4j/mcp/test/integration/helpers"
+)
+
+func TestVectorSearch(t *testing.T) {
+	t.Parallel()
+
+	tc := helpers.NewTestContext(t, dbs.GetDriver())
+	ctx := context.Background()
+
+	docLabel := tc.GetUniqueLabel("Doc")
+	for title, embedding := range map[string][]float64{"graphs": {1, 0, 0}, "tables": {0, 1, 0}, "documents": {0.9, 0.1, 0}} {
+		if _, err := tc.Service.ExecuteWriteQuery(ctx, fmt.Sprintf("CREATE (:%s {title: $title, embedding: $embedding})", docLabel),
+			map[string]any{"title": title, "embedding": embedding}); err != nil {
+			t.Fatalf("failed to seed data: %v", err)
+		}
+	}
+
+	index := "vector_" + tc.TestID
+	createIndex := fmt.Sprintf("CREATE VECTOR INDEX %s FOR (d:%s) ON d.embedding OPTIONS {indexConfig: {`vector.dimensions`: 3, `vector.similarity_function`: 'cosine'}}", index, docLabel)
+	if _, err := tc.Service.ExecuteWriteQuery(ctx, createIndex, nil); err != nil {
+		t.Fatalf("failed to create vector index: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = tc.Service.ExecuteWriteQuery(context.Background(), "DROP INDEX "+index+" IF EXISTS", nil)
+	})
+	if _, err := tc.Service.ExecuteWriteQuery(ctx, "CALL db.awaitIndexes(60)", nil); err != nil {
+		t.Fatalf("failed to wait for the vector index: %v", err)
+	}
+
+	t.Run("list-vector-indexes should list the index", func(t *testing.T) {
+		res := tc.CallTool(search.ListVectorIndexesHandler(tc.Deps), nil)
+
+		var indexes []map[string]any
+		tc.ParseJSONResponse(res, &indexes)
+		for _, i := range indexes {
+			if i["name"] == index {
+				if i["dimensions"] != float64(3) || i["entityType"] != "NODE" {
+					t.Errorf("expected a node index of 3 dimensions, got %v", i)
+				}
+				return
+			}
+		}
+		t.Fatalf("expected %s among the vector indexes, got %v", index, indexes)
+	})
+
+	t.Run("vector-search should return the closest nodes", func(t *testing.T) {
+		res := tc.CallTool(search.VectorSearchHandler(tc.Deps), map[string]any{
+			"index":  index,
+			"vector": []any{1.0, 0.0, 0.0},
+			"top_k":  2,
+		})
+
+		var records []map[string]any
+		tc.ParseJSONResponse(res, &records)
+		if len(records) != 2 {
+			t.Fatalf("expected 2 records, got %d", len(records))
+		}
+		node := records[0]["node"].(map[string]any)
+		tc.AssertNodeProperties(node, map[string]any{"title": "graphs"})
+		if embedding := node["properties"].(map[string]any)["embedding"].(map[string]any); embedding["type"] != "vector" {
+			t.Errorf("expected the indexed property as a vector placeholder, got %v", embedding)
+		}
+	})
+
+	t.Run("vector-search should filter the candidates", func(t *testing.T) {
+		res := tc.CallTool(search.VectorSearchHandler(tc.Deps), map[string]any{
+			"index":   index,
+			"vector":  []any{1.0, 0.0, 0.0},
+			"filters": []any{map[string]any{"property": "title", "operator": "<>", "value": "graphs"}},
+			"top_k":   1,
+		})
+
+		var records []map[string]any
+		tc.ParseJSONResponse(res, &records)
+		if len(records) != 1 {
+			t.Fatalf("expected 1 record, got %d", len(records))
+		}
+		tc.AssertNodeProperties(records[0]["node"].(map[string]any), map[string]any{"title": "documents"})
+	})
+}
@@ -3,8 +3,11 @@
 package integration
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/mark3labs/mcp-go/mcp"
+
 	"github.com/neo4j/mcp/internal/tools/cypher"
 	"github.com/neo4j/mcp/test/integration/helpers"
 )
@@ -16,10 +19,18 @@ func TestWriteCypher(t *testing.T) {
 	personLabel := tc.GetUniqueLabel("Person")
 
 	write := cypher.WriteCypherHandler(tc.Deps)
-	tc.CallTool(write, map[string]any{
+	res := tc.CallTool(write, map[string]any{
 		"query":  "CREATE (p:" + personLabel + " {name: $name}) RETURN p",
 		"params": map[string]any{"name": "Alice"},
 	})
 
 	tc.VerifyNodeInDB(personLabel, map[string]any{"name": "Alice"})
+
+	if len(res.Content) != 2 {
+		t.Fatalf("expected the records followed by the result summary, got %d contents", len(res.Content))
+	}
+	summary, ok := mcp.AsTextContent(res.Content[1])
+	if !ok || !strings.Contains(summary.Text, `"counters":{"nodesCreated":1,"propertiesSet":1,"labelsAdded":1}`) {
+		t.Errorf("expected the result summary to report the updates, got %+v", res.Content[1])
+	}
 }